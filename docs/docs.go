@@ -38,6 +38,26 @@ const docTemplate = `{
                 }
             }
         },
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "액세스 토큰 서명을 검증할 수 있는 공개 키 목록을 JWKS 형식으로 반환합니다. 키 교체 중에는 이전 키도 함께 포함됩니다. 공통 응답 형식으로 감싸지 않습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "액세스 토큰 검증 키",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_auth.JWKSet"
+                        }
+                    }
+                }
+            }
+        },
         "/activities": {
             "get": {
                 "security": [
@@ -214,9 +234,135 @@ const docTemplate = `{
                 }
             }
         },
+        "/auth/identities": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 계정에 연결된 소셜 로그인 제공자 목록을 연결한 순서대로 반환합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "연결된 소셜 계정 목록",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_IdentityListResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "다른 소셜 로그인 제공자를 현재 계정에 연결합니다. 연결 후에는 어느 제공자로 로그인해도 같은 계정으로 로그인됩니다. 제공자별로 하나의 계정만 연결할 수 있습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "소셜 계정 연결",
+                "parameters": [
+                    {
+                        "description": "연결할 소셜 계정 정보",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.LinkIdentityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_IdentityListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "INVALID_TOKEN",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "IDENTITY_ALREADY_LINKED / PROVIDER_ALREADY_LINKED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/identities/{provider}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "소셜 로그인 제공자 연결을 해제합니다. 마지막으로 남은 계정은 해제할 수 없습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "소셜 계정 연결 해제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "제공자 (GOOGLE, KAKAO, APPLE)",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "404": {
+                        "description": "IDENTITY_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "LAST_IDENTITY",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/login": {
             "post": {
-                "description": "Google/Kakao/Apple 소셜 로그인을 처리합니다. 신규 유저인 경우 자동 가입됩니다.",
+                "description": "Google/Kakao/Apple 소셜 로그인을 처리합니다. 신규 유저인 경우 자동 가입되고, 탈퇴 유예 중인 계정은 복구됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -256,6 +402,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "ACCOUNT_SUSPENDED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "RATE_LIMITED (Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
                     }
                 }
             }
@@ -290,6 +448,62 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_LoginResponse"
                         }
+                    },
+                    "429": {
+                        "description": "RATE_LIMITED (Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 세션을 종료합니다. 이 세션의 액세스 토큰과 refresh token 은 즉시 사용할 수 없습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "로그아웃",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout/others": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 세션을 제외한 모든 세션을 종료합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "다른 기기 모두 로그아웃",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
                     }
                 }
             }
@@ -331,7 +545,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "INVALID_NICKNAME",
+                        "description": "INVALID_NICKNAME / NICKNAME_NOT_ALLOWED",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -345,6 +559,83 @@ const docTemplate = `{
                 }
             }
         },
+        "/auth/refresh": {
+            "post": {
+                "description": "refresh token 으로 새 액세스 토큰과 refresh token 을 발급합니다. 사용한 refresh token 은 폐기되며, 이미 사용한 토큰을 다시 보내면 해당 로그인의 모든 토큰이 폐기됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "토큰 갱신",
+                "parameters": [
+                    {
+                        "description": "refresh token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_RefreshResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "INVALID_TOKEN / REFRESH_TOKEN_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "ACCOUNT_SUSPENDED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "RATE_LIMITED (Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 로그인되어 있는 세션(기기) 목록을 최근 사용 순으로 반환합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "로그인 기기 목록",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_SessionListResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/auth/withdraw": {
             "delete": {
                 "security": [
@@ -352,7 +643,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "계정을 삭제합니다. (미완성)",
+                "description": "탈퇴를 요청합니다. 계정은 즉시 다른 유저에게 보이지 않고 모든 기기에서 로그아웃되며, Apple 계정은 저장된 refresh token 을 바로 revoke 합니다. deleteAt 전에 다시 로그인하면 복구되고, 이후에는 모든 데이터가 삭제됩니다.",
                 "produces": [
                     "application/json"
                 ],
@@ -364,7 +655,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_WithdrawResponse"
                         }
                     }
                 }
@@ -458,73 +749,141 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends": {
+        "/exports/{export_id}/download": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "친구 목록을 반환합니다. 각 친구의 공백 상태를 포함합니다.",
+                "description": "GET /users/me/export 가 반환한 서명된 링크로 ZIP 파일을 내려받습니다",
                 "produces": [
-                    "application/json"
+                    "application/zip"
                 ],
                 "tags": [
-                    "Friends"
+                    "Users"
                 ],
-                "summary": "친구 목록 조회",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "개인 데이터 다운로드",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "내보내기 ID",
+                        "name": "export_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "링크 만료 시각 (unix)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "서명",
+                        "name": "sig",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendListResponse"
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "INVALID_DOWNLOAD_LINK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "EXPORT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/friends/requests": {
+        "/friends": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "받은(received) 또는 보낸(sent) 친구 요청 목록을 반환합니다",
+                "description": "친구 목록을 커서 페이지네이션으로 반환합니다. 각 친구의 공백 상태를 포함하며, 공백 상태를 나에게 공개하지 않은 친구는 공백 중이 아닌 것으로 보입니다.\n다음 페이지는 nextCursor 를 같은 sort, q 와 함께 보내 조회합니다.\nIN_VOID, RECENT 정렬은 페이지를 넘기는 사이 친구의 공백 상태가 바뀌면 순서가 달라져 일부 친구가 빠지거나 중복될 수 있습니다. 처음부터 다시 조회하면 정확한 목록을 받습니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Friends"
                 ],
-                "summary": "친구 요청 목록 조회",
+                "summary": "친구 목록 조회",
                 "parameters": [
                     {
-                        "enum": [
-                            "received",
-                            "sent"
-                        ],
                         "type": "string",
-                        "description": "요청 타입",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
+                        "description": "정렬 (FRIENDSHIP: 친구가 된 날짜 최신순(기본), IN_VOID: 공백 중인 친구 먼저, RECENT: 최근 활동순, NICKNAME: 닉네임순)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "닉네임·태그 검색어",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "이전 응답의 nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본 50, 최대 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "type=received",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ReceivedRequestsResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendListResponse"
                         }
                     },
                     "400": {
-                        "description": "INVALID_REQUEST_TYPE",
+                        "description": "INVALID_FRIEND_SORT / INVALID_CURSOR",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
+            }
+        },
+        "/friends/circles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "내가 만든 서클과 멤버를 반환합니다. 친한 친구 서클은 항상 맨 앞에 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Circles"
+                ],
+                "summary": "서클 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_CircleListResponse"
+                        }
+                    }
+                }
             },
             "post": {
                 "security": [
@@ -532,7 +891,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "유저에게 친구 요청을 보냅니다. 이미 친구이거나 차단 관계이면 실패합니다.",
+                "description": "빈 서클을 만듭니다. 이름은 1-20자입니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -540,17 +899,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Friends"
+                    "Circles"
                 ],
-                "summary": "친구 요청 보내기",
+                "summary": "서클 생성",
                 "parameters": [
                     {
-                        "description": "받는 유저 ID",
+                        "description": "서클 이름",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.SendFriendRequestRequest"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.CreateCircleRequest"
                         }
                     }
                 ],
@@ -558,17 +917,11 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_SendFriendRequestResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_CircleItem"
                         }
                     },
                     "400": {
-                        "description": "BLOCKED",
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "ALREADY_FRIENDS / REQUEST_ALREADY_SENT",
+                        "description": "INVALID_CIRCLE_NAME / TOO_MANY_CIRCLES",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -576,26 +929,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/requests/{request_id}": {
+        "/friends/circles/{circle_id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "보낸 친구 요청을 삭제합니다. 이미 수락된 요청은 삭제할 수 없습니다.",
+                "description": "서클을 삭제합니다. 친한 친구 서클과 공개 범위 설정에 쓰이고 있는 서클은 삭제할 수 없습니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Friends"
+                    "Circles"
                 ],
-                "summary": "친구 요청 삭제",
+                "summary": "서클 삭제",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "요청 ID",
-                        "name": "request_id",
+                        "description": "서클 ID",
+                        "name": "circle_id",
                         "in": "path",
                         "required": true
                     }
@@ -607,37 +960,59 @@ const docTemplate = `{
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
                         }
                     },
+                    "400": {
+                        "description": "CIRCLE_NOT_DELETABLE",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "REQUEST_NOT_FOUND",
+                        "description": "CIRCLE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "CIRCLE_IN_USE",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/friends/requests/{request_id}/accept": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "받은 친구 요청을 수락합니다. 양방향 친구 관계가 생성됩니다.",
+                "description": "서클 이름을 수정합니다",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Friends"
+                    "Circles"
                 ],
-                "summary": "친구 요청 수락",
+                "summary": "서클 이름 수정",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "요청 ID",
-                        "name": "request_id",
+                        "description": "서클 ID",
+                        "name": "circle_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "새 이름",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.UpdateCircleRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -648,13 +1023,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "REQUEST_NOT_PENDING",
+                        "description": "INVALID_CIRCLE_NAME",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "REQUEST_NOT_FOUND",
+                        "description": "CIRCLE_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -662,45 +1037,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/requests/{request_id}/reject": {
-            "post": {
+        "/friends/circles/{circle_id}/members": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "받은 친구 요청을 거절합니다. 요청은 삭제되지 않고 상태만 변경됩니다.",
+                "description": "서클 멤버를 보낸 목록으로 바꿉니다. 친구만 넣을 수 있으며, 친구를 끊거나 차단하면 서클에서도 빠집니다.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Friends"
+                    "Circles"
                 ],
-                "summary": "친구 요청 거절",
+                "summary": "서클 멤버 변경",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "요청 ID",
-                        "name": "request_id",
+                        "description": "서클 ID",
+                        "name": "circle_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "멤버 유저 ID 목록",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.SetCircleMembersRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_CircleItem"
                         }
                     },
                     "400": {
-                        "description": "REQUEST_NOT_PENDING",
+                        "description": "CIRCLE_MEMBER_NOT_FRIEND",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "REQUEST_NOT_FOUND",
+                        "description": "CIRCLE_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -708,39 +1095,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/{user_id}": {
-            "delete": {
+        "/friends/feed": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "양방향 친구 관계를 삭제합니다",
+                "description": "친구들이 마친 공백, 연속 공백 기념일, 최고 기록 경신을 최신순으로 반환합니다.\n공백 기록은 친구가 공개한 서클에게만, 연속·최고 기록은 통계를 볼 수 있을 때만 보이며, 활동은 친구가 활동 공개를 허용한 경우에만 포함됩니다. 끝난 공백에는 내가 남긴 반응이 함께 내려갑니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Friends"
                 ],
-                "summary": "친구 삭제",
+                "summary": "친구 피드 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "삭제할 친구 유저 ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
+                        "description": "이전 응답의 nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본 20, 최대 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FeedResponse"
                         }
                     },
-                    "404": {
-                        "description": "NOT_FRIENDS",
+                    "400": {
+                        "description": "INVALID_CURSOR",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -748,39 +1140,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/friends/{user_id}/nudge": {
+        "/friends/invites": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "공백 중인 친구에게 알림을 보냅니다. 친구가 공백 중이 아니면 실패합니다.",
+                "description": "공유할 수 있는 친구 초대 코드와 링크를 만듭니다. 코드를 사용한 유저와는 친구 요청 없이 바로 친구가 됩니다. 기본 72시간, 10회까지 사용할 수 있습니다.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Friends"
                 ],
-                "summary": "친구 찌르기",
+                "summary": "친구 초대 코드 생성",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "찌를 친구 유저 ID",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
+                        "description": "유효 기간과 최대 사용 횟수",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.CreateFriendInviteRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendInviteResponse"
                         }
                     },
                     "400": {
-                        "description": "NOT_FRIENDS / FRIEND_NOT_IN_VOID",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -788,28 +1184,63 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
-            "get": {
-                "description": "DB 연결 상태를 확인합니다",
+        "/friends/invites/{code}/redeem": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "초대 코드를 사용해 초대한 유저와 바로 친구가 됩니다. 두 유저 중 한쪽이라도 상대를 차단했으면 실패합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Friends"
+                ],
+                "summary": "친구 초대 코드 사용",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "초대 코드",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "헬스 체크",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_RedeemFriendInviteResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "INVITE_EXPIRED / INVITE_EXHAUSTED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "BLOCKED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "INVITE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "ALREADY_FRIENDS",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "RATE_LIMITED (Retry-After)",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -817,103 +1248,129 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications": {
+        "/friends/nudges": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "유저의 알림 목록을 페이지네이션으로 반환합니다",
+                "description": "오늘(16시 기준) 나를 찌른 친구를 마지막으로 찌른 시각 최신순으로 반환합니다. 같은 친구가 여러 번 찌르면 횟수로 묶습니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
-                ],
-                "summary": "알림 목록 조회",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "조회 개수 (기본 20)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "오프셋",
-                        "name": "offset",
-                        "in": "query"
-                    }
+                    "Friends"
                 ],
+                "summary": "오늘 밤 나를 찌른 친구",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_NotificationListResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_NudgeListResponse"
                         }
                     }
                 }
             }
         },
-        "/notifications/unread-count": {
+        "/friends/requests": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "읽지 않은 알림의 개수를 반환합니다",
+                "description": "받은(received) 또는 보낸(sent) 친구 요청 목록을 반환합니다. status 를 생략하면 받은 요청은 PENDING 만, 보낸 요청은 PENDING 과 EXPIRED 를 반환합니다. 보낸 사람에게 거절된 요청은 만료될 때까지 PENDING 으로 보입니다. 응답이 없거나 거절된 요청은 일정 기간이 지나면 EXPIRED 가 되고, 만료된 요청은 일정 기간 후 삭제됩니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Friends"
+                ],
+                "summary": "친구 요청 목록 조회",
+                "parameters": [
+                    {
+                        "enum": [
+                            "received",
+                            "sent"
+                        ],
+                        "type": "string",
+                        "description": "요청 타입",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "쉼표로 구분한 상태 필터 (PENDING, ACCEPTED, REJECTED, EXPIRED)",
+                        "name": "status",
+                        "in": "query"
+                    }
                 ],
-                "summary": "읽지 않은 알림 수 조회",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "type=received",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UnreadCountResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ReceivedRequestsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "INVALID_REQUEST_TYPE / INVALID_REQUEST_STATUS",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
-            }
-        },
-        "/notifications/{notification_id}/read": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "특정 알림을 읽음 상태로 변경합니다",
+                "description": "유저에게 친구 요청을 보냅니다. 이미 친구이거나 차단 관계이면 실패합니다. 거절된 상대에게는 거절 후 일정 기간 동안 다시 요청할 수 없습니다.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Friends"
                 ],
-                "summary": "알림 읽음 처리",
+                "summary": "친구 요청 보내기",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "알림 ID",
-                        "name": "notification_id",
-                        "in": "path",
-                        "required": true
+                        "description": "받는 유저 ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.SendFriendRequestRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_SendFriendRequestResponse"
                         }
                     },
-                    "404": {
-                        "description": "NOTIFICATION_NOT_FOUND",
+                    "400": {
+                        "description": "BLOCKED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "ALREADY_FRIENDS / REQUEST_ALREADY_SENT",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "FRIEND_REQUEST_COOLDOWN / RATE_LIMITED (Retry-After, data.retryAfterSec)",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -921,27 +1378,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/stats/daily": {
-            "get": {
+        "/friends/requests/{request_id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "특정 날짜의 20분 단위 버킷 통계와 내 공백 세션 목록을 반환합니다",
+                "description": "보낸 친구 요청을 삭제합니다. 이미 수락된 요청은 삭제할 수 없습니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Stats"
+                    "Friends"
                 ],
-                "summary": "일별 통계 조회",
+                "summary": "친구 요청 삭제",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "조회할 날짜 (YYYY-MM-DD)",
-                        "name": "target_day",
-                        "in": "query",
+                        "description": "요청 ID",
+                        "name": "request_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -949,150 +1406,222 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_DailyStatResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "404": {
+                        "description": "REQUEST_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/stats/home": {
-            "get": {
+        "/friends/requests/{request_id}/accept": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "현재 공백 중인 유저 수, 오늘 잠에 든 유저 수, 내 랭킹/총 공백 시간을 반환합니다",
+                "description": "받은 친구 요청을 수락합니다. 양방향 친구 관계가 생성됩니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Stats"
+                    "Friends"
+                ],
+                "summary": "친구 요청 수락",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "요청 ID",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "홈 통계 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_HomeStatResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "REQUEST_NOT_PENDING",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "REQUEST_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/stats/me": {
-            "get": {
+        "/friends/requests/{request_id}/reject": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "전체 공백 총 시간, 평균 시간, 최대 시간을 반환합니다",
+                "description": "받은 친구 요청을 거절합니다. 요청은 삭제되지 않고 상태만 변경됩니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Stats"
+                    "Friends"
+                ],
+                "summary": "친구 요청 거절",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "요청 ID",
+                        "name": "request_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "내 공백 통계 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_MyVoidStatResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "REQUEST_NOT_PENDING",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "REQUEST_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/users/blocks": {
+        "/friends/suggestions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "내가 차단한 유저 목록을 반환합니다",
+                "description": "친구의 친구를 함께 아는 친구가 많은 순으로 추천합니다. 이미 친구이거나 요청이 오가는 중인 유저, 차단 관계인 유저, 숨긴 추천은 제외합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Friends"
+                ],
+                "summary": "친구 추천",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "조회 개수 (기본 20, 최대 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "차단 목록 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_BlockListResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendSuggestionListResponse"
                         }
                     }
                 }
             }
         },
-        "/users/me": {
-            "get": {
+        "/friends/suggestions/{user_id}/dismiss": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "현재 로그인한 유저의 프로필과 알림 설정을 반환합니다",
+                "description": "해당 유저를 친구 추천에 다시 보여주지 않습니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Friends"
+                ],
+                "summary": "친구 추천 숨기기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "숨길 유저 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "내 정보 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UserMeResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "BAD_REQUEST",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "USER_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/users/me/nickname": {
-            "patch": {
+        "/friends/{user_id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "기존 닉네임을 변경합니다. 최초 설정은 /auth/nickname을 사용하세요.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "양방향 친구 관계를 삭제합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Friends"
                 ],
-                "summary": "닉네임 변경",
+                "summary": "친구 삭제",
                 "parameters": [
                     {
-                        "description": "새 닉네임 (3-15자)",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ChangeNicknameRequest"
-                        }
+                        "type": "string",
+                        "description": "삭제할 친구 유저 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ChangeNicknameResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
                         }
                     },
-                    "400": {
-                        "description": "INVALID_NICKNAME",
+                    "404": {
+                        "description": "NOT_FRIENDS",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -1100,105 +1629,165 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/settings": {
-            "patch": {
+        "/friends/{user_id}/nudge": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "알림 설정을 부분 업데이트합니다. 전달된 필드만 변경됩니다.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "공백 중인 친구에게 알림을 보냅니다. 친구가 공백 중이 아니면 실패합니다. 같은 친구는 일정 시간마다 한 번만 찌를 수 있고, 한 사람이 하루에 받을 수 있는 찌르기 수에도 제한이 있습니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Friends"
                 ],
-                "summary": "알림 설정 변경",
+                "summary": "친구 찌르기",
                 "parameters": [
                     {
-                        "description": "변경할 설정",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "찌를 친구 유저 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.UpdateSettingsRequest"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "NOT_FRIENDS / FRIEND_NOT_IN_VOID",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "NUDGE_COOLDOWN / NUDGE_DAILY_LIMIT / RATE_LIMITED (Retry-After, data.retryAfterSec)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "DB 연결 상태를 확인합니다",
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "헬스 체크",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UpdateSettingsResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/users/search": {
+        "/notifications": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "태그 접두사로 유저를 검색합니다. 나를 차단한 유저는 제외됩니다.",
+                "description": "유저의 알림 목록을 페이지네이션으로 반환합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Notifications"
                 ],
-                "summary": "유저 검색",
+                "summary": "알림 목록 조회",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "태그 접두사",
-                        "name": "tag",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "description": "조회 개수 (기본 20)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "오프셋",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UserSearchResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_NotificationListResponse"
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    }
+                }
+            }
+        },
+        "/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "읽지 않은 알림의 개수를 반환합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "읽지 않은 알림 수 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UnreadCountResponse"
                         }
                     }
                 }
             }
         },
-        "/users/{user_id}/block": {
-            "post": {
+        "/notifications/{notification_id}/read": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "유저를 차단합니다. 기존 친구 관계 및 친구 요청이 모두 삭제됩니다.",
+                "description": "특정 알림을 읽음 상태로 변경합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Notifications"
                 ],
-                "summary": "유저 차단",
+                "summary": "알림 읽음 처리",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "차단할 유저 ID",
-                        "name": "user_id",
+                        "description": "알림 ID",
+                        "name": "notification_id",
                         "in": "path",
                         "required": true
                     }
@@ -1211,13 +1800,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "USER_NOT_FOUND",
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "ALREADY_BLOCKED",
+                        "description": "NOTIFICATION_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
                         }
@@ -1225,27 +1808,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{user_id}/unblock": {
-            "post": {
+        "/stats/daily": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "차단을 해제합니다",
+                "description": "특정 날짜의 20분 단위 버킷 통계와 내 공백 세션 목록을 반환합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Stats"
                 ],
-                "summary": "유저 차단 해제",
+                "summary": "일별 통계 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "차단 해제할 유저 ID",
-                        "name": "user_id",
-                        "in": "path",
+                        "description": "조회할 날짜 (YYYY-MM-DD)",
+                        "name": "target_day",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -1253,200 +1836,1207 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
-                        }
-                    },
-                    "400": {
-                        "description": "NOT_BLOCKED",
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_DailyStatResponse"
                         }
                     }
                 }
             }
         },
-        "/void/cancel": {
-            "post": {
+        "/stats/home": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "진행 중인 공백을 취소합니다. 세션 기록이 남지 않습니다.",
+                "description": "현재 공백 중인 유저 수, 오늘 잠에 든 유저 수, 내 랭킹/총 공백 시간을 반환합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Void"
+                    "Stats"
                 ],
-                "summary": "공백 취소",
+                "summary": "홈 통계 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
-                        }
-                    },
-                    "400": {
-                        "description": "NOT_IN_VOID",
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_HomeStatResponse"
                         }
                     }
                 }
             }
         },
-        "/void/end": {
-            "post": {
+        "/stats/me": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "진행 중인 공백 세션을 종료하고 활동을 기록합니다. 활동은 최대 5개.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "전체 공백 총 시간, 평균 시간, 최대 시간을 반환합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Void"
-                ],
-                "summary": "공백 종료",
-                "parameters": [
-                    {
-                        "description": "종료 시 기록할 활동 목록",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.VoidEndRequest"
-                        }
-                    }
+                    "Stats"
                 ],
+                "summary": "내 공백 통계 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidEndResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "NOT_IN_VOID / TOO_MANY_ACTIVITIES / ACTIVITY_NOT_FOUND",
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_MyVoidStatResponse"
                         }
                     }
                 }
             }
         },
-        "/void/history": {
+        "/users/blocks": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "특정 날짜의 공백 세션 목록과 총 시간을 반환합니다. 날짜 기준은 KST 16:00.",
+                "description": "내가 차단한 유저 목록을 반환합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Void"
-                ],
-                "summary": "공백 히스토리 조회",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "조회할 날짜 (YYYY-MM-DD)",
-                        "name": "target_day",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Users"
                 ],
+                "summary": "차단 목록 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidHistoryResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_BlockListResponse"
                         }
                     }
                 }
             }
         },
-        "/void/start": {
-            "post": {
+        "/users/me": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "공백(밤의 공백) 세션을 시작합니다. 이미 공백 중이면 실패합니다.",
+                "description": "현재 로그인한 유저의 프로필과 알림 설정을 반환합니다",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Void"
+                    "Users"
                 ],
-                "summary": "공백 시작",
+                "summary": "내 정보 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidStartResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "ALREADY_IN_VOID",
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UserMeResponse"
                         }
                     }
                 }
             }
         },
-        "/void/test": {
-            "post": {
+        "/users/me/avatar": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "개발용. 임의의 시작/종료 시각으로 공백 세션을 생성합니다.",
+                "description": "JPEG/PNG 이미지를 업로드합니다. (HEIC 는 클라이언트에서 JPEG 로 변환) EXIF 를 제거하고 정사각형 썸네일(128, 512)로 저장합니다.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Void"
+                    "Users"
                 ],
-                "summary": "테스트 공백 생성",
+                "summary": "아바타 업로드",
                 "parameters": [
                     {
-                        "description": "테스트 공백 데이터",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.TestVoidRequest"
-                        }
+                        "type": "file",
+                        "description": "아바타 이미지",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidEndResponse"
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_AvatarResponse"
                         }
-                    }
-                }
-            }
-        }
-    },
+                    },
+                    "400": {
+                        "description": "INVALID_IMAGE",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "IMAGE_TOO_LARGE (파일 크기 초과, 가로·세로 8000px 또는 전체 4096×4096px 초과)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "등록된 아바타를 삭제합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "아바타 삭제",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "가장 최근 내보내기 작업의 상태를 반환합니다. READY 이면 로그인 없이 받을 수 있는 서명된 다운로드 링크가 포함되며, 링크가 만료되면 다시 조회해 새 링크를 받습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "개인 데이터 내보내기 상태",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_DataExportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "EXPORT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "내 계정 정보, 활동, 밤샘 기록, 친구, 친구 요청, 차단, 알림, 디바이스 토큰, 찌르기 기록, 서클, 숨긴 친구 추천, 피드 활동, 공백 반응, 함께 공백한 방을 JSON 과 CSV 로 묶은 ZIP 파일을 만듭니다. 완료 여부는 GET /users/me/export 로 확인합니다. 진행 중인 작업이 있으면 그 작업을 반환하며, 24시간에 한 번만 요청할 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "개인 데이터 내보내기 요청",
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_DataExportResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "EXPORT_RATE_LIMITED (Retry-After, data.retryAfterSec)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/nickname": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "기존 닉네임을 변경합니다. 최초 설정은 /auth/nickname을 사용하세요. 변경 후 일정 기간 동안 다시 변경할 수 없습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "닉네임 변경",
+                "parameters": [
+                    {
+                        "description": "새 닉네임 (3-15자)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ChangeNicknameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ChangeNicknameResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "INVALID_NICKNAME / NICKNAME_NOT_ALLOWED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "NICKNAME_CHANGE_COOLDOWN (data.nextChangeAt)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "CONFLICT (동시에 들어온 다른 변경 요청이 먼저 반영됨)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/privacy": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "프로필 통계(연속 공백 일수, 이번 주 총 공백 시간)의 공개 범위를 변경합니다 (EVERYONE, FRIENDS, NOBODY).\n통계·공백 상태·피드의 공백 기록을 볼 수 있는 친구를 서클로 좁힐 수 있으며, 빈 배열이면 모든 친구에게 공개합니다.\nshareActivities 를 켜면 피드의 공백 기록에 활동도 함께 보입니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "공개 범위 설정 변경",
+                "parameters": [
+                    {
+                        "description": "변경할 설정",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.UpdatePrivacyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_PrivacySettings"
+                        }
+                    },
+                    "400": {
+                        "description": "INVALID_PRIVACY_SETTING",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "CIRCLE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/settings": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "알림 설정을 부분 업데이트합니다. 전달된 필드만 변경됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "알림 설정 변경",
+                "parameters": [
+                    {
+                        "description": "변경할 설정",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.UpdateSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UpdateSettingsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/tag": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "랜덤 태그 대신 원하는 태그(영문/숫자 4-12자)를 설정합니다. 대소문자는 구분하지 않으며, 변경 전 태그는 일정 기간 예약됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "태그 변경",
+                "parameters": [
+                    {
+                        "description": "새 태그",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ChangeTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ChangeTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "INVALID_TAG",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "TAG_CHANGE_COOLDOWN (data.nextChangeAt)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "TAG_ALREADY_TAKEN",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "태그 접두사로 유저를 검색합니다. 나를 차단한 유저는 제외됩니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "유저 검색",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "태그 접두사",
+                        "name": "tag",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UserSearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "RATE_LIMITED (Retry-After)",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "다른 유저의 프로필과 친구 관계 상태를 반환합니다. 통계는 대상 유저의 공개 범위에 따라 null일 수 있습니다. 나를 차단한 유저는 조회할 수 없습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "유저 프로필 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "유저 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UserProfileResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "USER_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/block": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "유저를 차단합니다. 기존 친구 관계 및 친구 요청이 모두 삭제됩니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "유저 차단",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "차단할 유저 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "404": {
+                        "description": "USER_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "ALREADY_BLOCKED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/unblock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "차단을 해제합니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "유저 차단 해제",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "차단 해제할 유저 ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "NOT_BLOCKED",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "진행 중인 공백을 취소합니다. 세션 기록이 남지 않습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 취소",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "NOT_IN_VOID",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/end": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "진행 중인 공백 세션을 종료하고 활동을 기록합니다. 활동은 최대 5개. 공백 방에 들어가 있으면 세션이 그 방에 연결됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 종료",
+                "parameters": [
+                    {
+                        "description": "종료 시 기록할 활동 목록",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.VoidEndRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidEndResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "NOT_IN_VOID / TOO_MANY_ACTIVITIES / ACTIVITY_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "특정 날짜의 공백 세션 목록과 총 시간을 반환합니다. 날짜 기준은 KST 16:00. 세션마다 친구들이 남긴 반응 수를 이모지별로 함께 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 히스토리 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "조회할 날짜 (YYYY-MM-DD)",
+                        "name": "target_day",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidHistoryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/rooms": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "친구들과 함께 공백할 방을 만들고 친구를 초대합니다. 만든 사람은 바로 방에 들어가며, 한 번에 한 방에만 있을 수 있습니다.\n방에 있는 동안 끝낸 공백은 방에 연결되고, 방 멤버끼리는 공개 범위 설정과 관계없이 서로의 공백 여부와 시간이 보입니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 방 만들기",
+                "parameters": [
+                    {
+                        "description": "초대할 친구 유저 ID 목록",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.CreateVoidRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidRoomResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "ROOM_INVITEE_NOT_FRIEND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "ALREADY_IN_ROOM",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/rooms/current": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "지금 들어가 있는 방과 멤버별 공백 여부, 진행 시간, 방에서 공백한 시간을 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "내 공백 방 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidRoomResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "ROOM_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/rooms/{room_id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "들어갔던 방이나 초대받은 열린 방을 반환합니다. 멤버별 공백 여부와 진행 시간은 방 멤버에게만 보입니다. 닫힌 방은 닫힐 때의 합계를 보여줍니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 방 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "방 ID",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidRoomResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "ROOM_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/rooms/{room_id}/invite": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "방 멤버가 자기 친구를 방에 초대합니다. 이미 초대받은 친구에게는 알림을 다시 보내지 않습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 방 초대",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "방 ID",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "초대할 친구 유저 ID 목록",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.InviteVoidRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "ROOM_INVITEE_NOT_FRIEND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "ROOM_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/rooms/{room_id}/join": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "초대받은 방에 들어갑니다. 방 멤버에게 알림을 보냅니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 방 들어가기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "방 ID",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidRoomResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "ROOM_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "ALREADY_IN_ROOM / ROOM_FULL",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/rooms/{room_id}/leave": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "방에서 나갑니다. 공백 중에 나가면 그 공백은 방에 연결되지 않습니다. 마지막 멤버가 나가면 방이 닫히고 방 전체 합계가 반환됩니다. 공백 중인 멤버 없이 VOID_ROOM_IDLE_TIMEOUT(기본 6시간) 동안 활동이 없는 방도 자동으로 닫힙니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 방 나가기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "방 ID",
+                        "name": "room_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidRoomResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "ROOM_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/sessions/{session_id}/reaction": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "친구의 끝난 공백에 반응을 남깁니다. 세션마다 하나만 남길 수 있으며, 다시 보내면 반응이 바뀝니다. 반응은 모아서 세션 주인에게 알림을 보냅니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 반응 남기기",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "세션 ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "반응 (CLAP, FIRE, HEART, MOON, COFFEE)",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.SessionReactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "400": {
+                        "description": "INVALID_REACTION",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "SESSION_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "친구의 공백에 남긴 반응을 지웁니다",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 반응 취소",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "세션 ID",
+                        "name": "session_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-any"
+                        }
+                    },
+                    "404": {
+                        "description": "SESSION_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "공백(밤의 공백) 세션을 시작합니다. 이미 공백 중이면 실패합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "공백 시작",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidStartResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "ALREADY_IN_VOID",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/void/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "개발용. 임의의 시작/종료 시각으로 공백 세션을 생성합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Void"
+                ],
+                "summary": "테스트 공백 생성",
+                "parameters": [
+                    {
+                        "description": "테스트 공백 데이터",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.TestVoidRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidEndResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
     "definitions": {
+        "dangbamgong-backend_internal_auth.JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "crv": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                },
+                "x": {
+                    "type": "string"
+                },
+                "y": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_auth.JWKSet": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_auth.JWK"
+                    }
+                }
+            }
+        },
         "dangbamgong-backend_internal_domain.ErrorCode": {
             "type": "string",
             "enum": [
@@ -1457,15 +3047,40 @@ const docTemplate = `{
                 "FORBIDDEN",
                 "CONFLICT",
                 "SERVICE_UNAVAILABLE",
+                "RATE_LIMITED",
+                "INVALID_CURSOR",
                 "INVALID_TOKEN",
                 "INVALID_NICKNAME",
                 "NICKNAME_ALREADY_SET",
+                "NICKNAME_NOT_ALLOWED",
+                "REFRESH_TOKEN_REUSED",
+                "ACCOUNT_SUSPENDED",
+                "IDENTITY_ALREADY_LINKED",
+                "PROVIDER_ALREADY_LINKED",
+                "IDENTITY_NOT_FOUND",
+                "LAST_IDENTITY",
                 "USER_NOT_FOUND",
                 "ALREADY_BLOCKED",
                 "NOT_BLOCKED",
+                "NICKNAME_CHANGE_COOLDOWN",
+                "INVALID_TAG",
+                "TAG_ALREADY_TAKEN",
+                "TAG_CHANGE_COOLDOWN",
+                "INVALID_IMAGE",
+                "IMAGE_TOO_LARGE",
+                "INVALID_PRIVACY_SETTING",
+                "EXPORT_NOT_FOUND",
+                "EXPORT_RATE_LIMITED",
+                "INVALID_DOWNLOAD_LINK",
                 "ALREADY_IN_VOID",
                 "NOT_IN_VOID",
                 "TOO_MANY_ACTIVITIES",
+                "SESSION_NOT_FOUND",
+                "INVALID_REACTION",
+                "ROOM_NOT_FOUND",
+                "ALREADY_IN_ROOM",
+                "ROOM_FULL",
+                "ROOM_INVITEE_NOT_FRIEND",
                 "INVALID_ACTIVITY_NAME",
                 "ACTIVITY_ALREADY_EXISTS",
                 "ACTIVITY_NOT_FOUND",
@@ -1477,7 +3092,21 @@ const docTemplate = `{
                 "REQUEST_NOT_PENDING",
                 "NOT_FRIENDS",
                 "FRIEND_NOT_IN_VOID",
-                "INVALID_REQUEST_TYPE"
+                "INVALID_REQUEST_TYPE",
+                "INVALID_REQUEST_STATUS",
+                "INVALID_FRIEND_SORT",
+                "FRIEND_REQUEST_COOLDOWN",
+                "NUDGE_COOLDOWN",
+                "NUDGE_DAILY_LIMIT",
+                "INVITE_NOT_FOUND",
+                "INVITE_EXPIRED",
+                "INVITE_EXHAUSTED",
+                "CIRCLE_NOT_FOUND",
+                "INVALID_CIRCLE_NAME",
+                "TOO_MANY_CIRCLES",
+                "CIRCLE_NOT_DELETABLE",
+                "CIRCLE_IN_USE",
+                "CIRCLE_MEMBER_NOT_FRIEND"
             ],
             "x-enum-varnames": [
                 "ErrBadRequest",
@@ -1487,15 +3116,40 @@ const docTemplate = `{
                 "ErrForbidden",
                 "ErrConflict",
                 "ErrServiceUnavailable",
+                "ErrRateLimited",
+                "ErrInvalidCursor",
                 "ErrInvalidToken",
                 "ErrInvalidNickname",
                 "ErrNicknameAlreadySet",
+                "ErrNicknameNotAllowed",
+                "ErrRefreshTokenReused",
+                "ErrAccountSuspended",
+                "ErrIdentityLinked",
+                "ErrProviderLinked",
+                "ErrIdentityNotFound",
+                "ErrLastIdentity",
                 "ErrUserNotFound",
                 "ErrAlreadyBlocked",
                 "ErrNotBlocked",
+                "ErrNicknameChangeCooldown",
+                "ErrInvalidTag",
+                "ErrTagAlreadyTaken",
+                "ErrTagChangeCooldown",
+                "ErrInvalidImage",
+                "ErrImageTooLarge",
+                "ErrInvalidPrivacySetting",
+                "ErrExportNotFound",
+                "ErrExportRateLimited",
+                "ErrInvalidDownloadLink",
                 "ErrAlreadyInVoid",
                 "ErrNotInVoid",
                 "ErrTooManyActivities",
+                "ErrSessionNotFound",
+                "ErrInvalidReaction",
+                "ErrRoomNotFound",
+                "ErrAlreadyInRoom",
+                "ErrRoomFull",
+                "ErrRoomInviteeNotFriend",
                 "ErrInvalidActivityName",
                 "ErrActivityAlreadyExists",
                 "ErrActivityNotFound",
@@ -1507,7 +3161,21 @@ const docTemplate = `{
                 "ErrRequestNotPending",
                 "ErrNotFriends",
                 "ErrFriendNotInVoid",
-                "ErrInvalidRequestType"
+                "ErrInvalidRequestType",
+                "ErrInvalidRequestStatus",
+                "ErrInvalidFriendSort",
+                "ErrRequestCooldown",
+                "ErrNudgeCooldown",
+                "ErrNudgeDailyLimit",
+                "ErrInviteNotFound",
+                "ErrInviteExpired",
+                "ErrInviteExhausted",
+                "ErrCircleNotFound",
+                "ErrInvalidCircleName",
+                "ErrTooManyCircles",
+                "ErrCircleNotDeletable",
+                "ErrCircleInUse",
+                "ErrCircleMemberNotFriend"
             ]
         },
         "dangbamgong-backend_internal_dto.ActivityItem": {
@@ -1538,6 +3206,27 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.AvatarResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.AvatarURLs"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.AvatarURLs": {
+            "type": "object",
+            "properties": {
+                "large": {
+                    "description": "512x512",
+                    "type": "string"
+                },
+                "small": {
+                    "description": "128x128",
+                    "type": "string"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.BlockItem": {
             "type": "object",
             "properties": {
@@ -1601,6 +3290,61 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.ChangeTagRequest": {
+            "type": "object",
+            "required": [
+                "tag"
+            ],
+            "properties": {
+                "tag": {
+                    "type": "string",
+                    "maxLength": 12,
+                    "minLength": 4
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.ChangeTagResponse": {
+            "type": "object",
+            "properties": {
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.CircleItem": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isCloseFriends": {
+                    "type": "boolean"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserSearchItem"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.CircleListResponse": {
+            "type": "object",
+            "properties": {
+                "circles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.CircleItem"
+                    }
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.CreateActivityRequest": {
             "type": "object",
             "required": [
@@ -1631,6 +3375,48 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.CreateCircleRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.CreateFriendInviteRequest": {
+            "type": "object",
+            "properties": {
+                "expiresInHours": {
+                    "description": "생략하면 72시간",
+                    "type": "integer",
+                    "maximum": 720,
+                    "minimum": 1
+                },
+                "maxUses": {
+                    "description": "생략하면 10회",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.CreateVoidRoomRequest": {
+            "type": "object",
+            "properties": {
+                "userIds": {
+                    "description": "초대할 친구",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.DailyStatResponse": {
             "type": "object",
             "properties": {
@@ -1651,20 +3437,162 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.DataExportResponse": {
+            "type": "object",
+            "properties": {
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "downloadUrl": {
+                    "description": "READY 일 때만, 로그인 없이 받을 수 있는 서명된 링크",
+                    "type": "string"
+                },
+                "downloadUrlExpireAt": {
+                    "description": "만료되면 다시 조회해 새 링크를 받음",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "파일 보관 기한",
+                    "type": "string"
+                },
+                "exportId": {
+                    "type": "string"
+                },
+                "sizeBytes": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "PENDING, READY, FAILED",
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.DeviceInfo": {
+            "type": "object",
+            "properties": {
+                "appVersion": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "예: \"iPhone 15 Pro\"",
+                    "type": "string"
+                },
+                "platform": {
+                    "description": "예: \"iOS 18.1\"",
+                    "type": "string"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.ErrorResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "$ref": "#/definitions/dangbamgong-backend_internal_domain.ErrorCode"
                 },
+                "data": {},
                 "success": {
                     "type": "boolean"
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.FeedItem": {
+            "type": "object",
+            "properties": {
+                "activities": {
+                    "description": "활동을 공개한 친구의 SESSION_ENDED 만, 아니면 null",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "durationSec": {
+                    "description": "SESSION_ENDED, PERSONAL_RECORD",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "myReaction": {
+                    "description": "SESSION_ENDED 에 내가 남긴 반응, 없으면 null",
+                    "type": "string"
+                },
+                "sessionId": {
+                    "description": "반응을 남길 세션",
+                    "type": "string"
+                },
+                "streakDays": {
+                    "description": "STREAK_MILESTONE",
+                    "type": "integer"
+                },
+                "targetDay": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "SESSION_ENDED, STREAK_MILESTONE, PERSONAL_RECORD",
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserSearchItem"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.FeedResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.FeedItem"
+                    }
+                },
+                "nextCursor": {
+                    "description": "마지막 페이지면 null",
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.FriendInviteResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "maxUses": {
+                    "type": "integer"
+                },
+                "url": {
+                    "description": "초대 링크 주소가 설정되지 않은 경우 null",
+                    "type": "string"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.FriendItem": {
             "type": "object",
             "properties": {
+                "avatar": {
+                    "description": "아바타가 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.AvatarURLs"
+                        }
+                    ]
+                },
+                "circleIds": {
+                    "description": "친구가 속한 내 서클",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "createdAt": {
                     "type": "string"
                 },
@@ -1672,11 +3600,16 @@ const docTemplate = `{
                     "type": "boolean"
                 },
                 "lastVoidEndedAt": {
+                    "description": "공백 상태를 공개하지 않는 친구면 isInVoid 는 false, lastVoidEndedAt 은 null",
                     "type": "string"
                 },
                 "nickname": {
                     "type": "string"
                 },
+                "previousNickname": {
+                    "description": "최근 닉네임을 변경한 경우 이전 닉네임",
+                    "type": "string"
+                },
                 "tag": {
                     "type": "string"
                 },
@@ -1685,36 +3618,136 @@ const docTemplate = `{
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.FriendListResponse": {
+        "dangbamgong-backend_internal_dto.FriendListResponse": {
+            "type": "object",
+            "properties": {
+                "friends": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.FriendItem"
+                    }
+                },
+                "nextCursor": {
+                    "description": "마지막 페이지면 null",
+                    "type": "string"
+                },
+                "totalCount": {
+                    "description": "검색어에 맞는 전체 친구 수",
+                    "type": "integer"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.FriendSuggestionItem": {
+            "type": "object",
+            "properties": {
+                "mutualCount": {
+                    "type": "integer"
+                },
+                "mutualNicknames": {
+                    "description": "함께 아는 친구 중 최대 3명",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserSearchItem"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.FriendSuggestionListResponse": {
+            "type": "object",
+            "properties": {
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.FriendSuggestionItem"
+                    }
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.HomeStatResponse": {
+            "type": "object",
+            "properties": {
+                "currentVoidCount": {
+                    "description": "공통",
+                    "type": "integer"
+                },
+                "myRank": {
+                    "type": "integer"
+                },
+                "myTotalDurationSec": {
+                    "description": "오늘 잠에 든 유저",
+                    "type": "integer"
+                },
+                "todaySleptCount": {
+                    "type": "integer"
+                },
+                "totalSleptUsers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.IdentityItem": {
+            "type": "object",
+            "properties": {
+                "lastUsedAt": {
+                    "type": "string"
+                },
+                "linkedAt": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.IdentityListResponse": {
+            "type": "object",
+            "properties": {
+                "identities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.IdentityItem"
+                    }
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.InviteVoidRoomRequest": {
             "type": "object",
             "properties": {
-                "friends": {
+                "userIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.FriendItem"
+                        "type": "string"
                     }
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.HomeStatResponse": {
+        "dangbamgong-backend_internal_dto.LinkIdentityRequest": {
             "type": "object",
+            "required": [
+                "idToken",
+                "provider"
+            ],
             "properties": {
-                "currentVoidCount": {
-                    "description": "공통",
-                    "type": "integer"
-                },
-                "myRank": {
-                    "type": "integer"
+                "appleRefreshToken": {
+                    "type": "string"
                 },
-                "myTotalDurationSec": {
-                    "description": "오늘 잠에 든 유저",
-                    "type": "integer"
+                "idToken": {
+                    "type": "string"
                 },
-                "todaySleptCount": {
-                    "type": "integer"
+                "nonce": {
+                    "description": "APPLE 필수: identity token 요청에 사용한 원본 nonce",
+                    "type": "string"
                 },
-                "totalSleptUsers": {
-                    "type": "integer"
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "GOOGLE",
+                        "KAKAO",
+                        "APPLE"
+                    ]
                 }
             }
         },
@@ -1728,7 +3761,15 @@ const docTemplate = `{
                 "appleRefreshToken": {
                     "type": "string"
                 },
+                "device": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.DeviceInfo"
+                },
                 "idToken": {
+                    "description": "KAKAO 는 OIDC ID 토큰 또는 액세스 토큰",
+                    "type": "string"
+                },
+                "nonce": {
+                    "description": "APPLE 필수: identity token 요청에 사용한 원본 nonce (토큰에는 SHA-256 hex)",
                     "type": "string"
                 },
                 "provider": {
@@ -1749,6 +3790,13 @@ const docTemplate = `{
                 },
                 "isNewUser": {
                     "type": "boolean"
+                },
+                "isRestored": {
+                    "description": "탈퇴 유예 중인 계정이 복구됨",
+                    "type": "boolean"
+                },
+                "refreshToken": {
+                    "type": "string"
                 }
             }
         },
@@ -1820,6 +3868,77 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.NudgeItem": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "lastNudgedAt": {
+                    "type": "string"
+                },
+                "sender": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserSearchItem"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.NudgeListResponse": {
+            "type": "object",
+            "properties": {
+                "nudges": {
+                    "description": "마지막으로 찌른 시각 최신순",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.NudgeItem"
+                    }
+                },
+                "targetDay": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.PrivacySettings": {
+            "type": "object",
+            "properties": {
+                "presenceCircles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sessionCircles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "shareActivities": {
+                    "type": "boolean"
+                },
+                "statsCircles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "statsVisibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.ProfileStats": {
+            "type": "object",
+            "properties": {
+                "streakDays": {
+                    "description": "오늘(또는 어제)까지 연속으로 공백한 일수",
+                    "type": "integer"
+                },
+                "weekTotalDurationSec": {
+                    "description": "이번 주(월요일 시작) 총 공백 시간",
+                    "type": "integer"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.ReceivedRequestItem": {
             "type": "object",
             "properties": {
@@ -1831,6 +3950,9 @@ const docTemplate = `{
                 },
                 "sender": {
                     "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserSearchItem"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
@@ -1845,141 +3967,315 @@ const docTemplate = `{
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.RegisterDeviceRequest": {
+        "dangbamgong-backend_internal_dto.RedeemFriendInviteResponse": {
+            "type": "object",
+            "properties": {
+                "friend": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserSearchItem"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.RefreshResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "description": "사용한 refresh token 은 폐기되므로 반드시 교체",
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.RegisterDeviceRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-any": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ActivityListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.ActivityListResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_AvatarResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.AvatarResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_BlockListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.BlockListResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ChangeNicknameResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.ChangeNicknameResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ChangeTagResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.ChangeTagResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_CircleItem": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.CircleItem"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_CircleListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.CircleListResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_CreateActivityResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.CreateActivityResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_DailyStatResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.DailyStatResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_DataExportResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.DataExportResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FeedResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.FeedResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendInviteResponse": {
             "type": "object",
-            "required": [
-                "token"
-            ],
             "properties": {
-                "token": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.FriendInviteResponse"
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-any": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendListResponse": {
             "type": "object",
             "properties": {
-                "data": {},
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.FriendListResponse"
+                },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ActivityListResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendSuggestionListResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.ActivityListResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.FriendSuggestionListResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_BlockListResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_HomeStatResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.BlockListResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.HomeStatResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ChangeNicknameResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_IdentityListResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.ChangeNicknameResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.IdentityListResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_CreateActivityResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_LoginResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.CreateActivityResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.LoginResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_DailyStatResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_MyVoidStatResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.DailyStatResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.MyVoidStatResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_FriendListResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_NotificationListResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.FriendListResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.NotificationListResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_HomeStatResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_NudgeListResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.HomeStatResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.NudgeListResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_LoginResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_PrivacySettings": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.LoginResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.PrivacySettings"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_MyVoidStatResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ReceivedRequestsResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.MyVoidStatResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.ReceivedRequestsResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_NotificationListResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_RedeemFriendInviteResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.NotificationListResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.RedeemFriendInviteResponse"
                 },
                 "success": {
                     "type": "boolean"
                 }
             }
         },
-        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_ReceivedRequestsResponse": {
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_RefreshResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.ReceivedRequestsResponse"
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.RefreshResponse"
                 },
                 "success": {
                     "type": "boolean"
@@ -1997,6 +4293,17 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_SessionListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.SessionListResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_SetNicknameResponse": {
             "type": "object",
             "properties": {
@@ -2041,6 +4348,17 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UserProfileResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserProfileResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_UserSearchResponse": {
             "type": "object",
             "properties": {
@@ -2074,6 +4392,17 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidRoomResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.VoidRoomResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_VoidStartResponse": {
             "type": "object",
             "properties": {
@@ -2085,6 +4414,17 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.Response-dangbamgong-backend_internal_dto_WithdrawResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.WithdrawResponse"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.SendFriendRequestRequest": {
             "type": "object",
             "required": [
@@ -2104,6 +4444,69 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.SessionItem": {
+            "type": "object",
+            "properties": {
+                "appVersion": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deviceName": {
+                    "type": "string"
+                },
+                "isCurrent": {
+                    "description": "현재 요청의 세션",
+                    "type": "boolean"
+                },
+                "lastSeenAt": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "sessionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.SessionListResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.SessionItem"
+                    }
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.SessionReactionRequest": {
+            "type": "object",
+            "properties": {
+                "emoji": {
+                    "description": "CLAP, FIRE, HEART, MOON, COFFEE",
+                    "type": "string",
+                    "example": "CLAP"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.SetCircleMembersRequest": {
+            "type": "object",
+            "properties": {
+                "userIds": {
+                    "description": "친구만 넣을 수 있음. 빈 배열이면 모두 제외",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.SetNicknameRequest": {
             "type": "object",
             "required": [
@@ -2131,6 +4534,9 @@ const docTemplate = `{
                 "socialId"
             ],
             "properties": {
+                "device": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.DeviceInfo"
+                },
                 "socialId": {
                     "type": "string"
                 }
@@ -2174,6 +4580,53 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.UpdateCircleRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "minLength": 1
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.UpdatePrivacyRequest": {
+            "type": "object",
+            "properties": {
+                "presenceCircles": {
+                    "description": "공백 중 여부를 볼 수 있는 서클",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sessionCircles": {
+                    "description": "친구 피드에서 공백 기록을 볼 수 있는 서클",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "shareActivities": {
+                    "description": "친구 피드의 공백 기록에 활동을 함께 공개",
+                    "type": "boolean"
+                },
+                "statsCircles": {
+                    "description": "statsVisibility 가 FRIENDS 일 때 통계를 볼 수 있는 서클",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "statsVisibility": {
+                    "description": "EVERYONE, FRIENDS, NOBODY",
+                    "type": "string"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.UpdateSettingsRequest": {
             "type": "object",
             "properties": {
@@ -2211,6 +4664,14 @@ const docTemplate = `{
         "dangbamgong-backend_internal_dto.UserMeResponse": {
             "type": "object",
             "properties": {
+                "avatar": {
+                    "description": "아바타가 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.AvatarURLs"
+                        }
+                    ]
+                },
                 "currentVoidStartedAt": {
                     "type": "string"
                 },
@@ -2223,17 +4684,66 @@ const docTemplate = `{
                 "nickname": {
                     "type": "string"
                 },
+                "nicknameChangeableAt": {
+                    "description": "변경 대기 중이 아니면 null",
+                    "type": "string"
+                },
                 "notificationSettings": {
                     "$ref": "#/definitions/dangbamgong-backend_internal_dto.NotificationSettings"
                 },
+                "privacySettings": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.PrivacySettings"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.UserProfileResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "description": "아바타가 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.AvatarURLs"
+                        }
+                    ]
+                },
+                "friendshipStatus": {
+                    "description": "SELF, FRIEND, PENDING_SENT, PENDING_RECEIVED, BLOCKED, NONE",
+                    "type": "string"
+                },
+                "nickname": {
+                    "type": "string"
+                },
+                "stats": {
+                    "description": "공개하지 않는 유저면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.ProfileStats"
+                        }
+                    ]
+                },
                 "tag": {
                     "type": "string"
+                },
+                "userId": {
+                    "type": "string"
                 }
             }
         },
         "dangbamgong-backend_internal_dto.UserSearchItem": {
             "type": "object",
             "properties": {
+                "avatar": {
+                    "description": "아바타가 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dangbamgong-backend_internal_dto.AvatarURLs"
+                        }
+                    ]
+                },
                 "isBlocked": {
                     "type": "boolean"
                 },
@@ -2286,6 +4796,10 @@ const docTemplate = `{
                 "endedAt": {
                     "type": "string"
                 },
+                "roomId": {
+                    "description": "세션이 연결된 공백 방, 없으면 null",
+                    "type": "string"
+                },
                 "sessionId": {
                     "type": "string"
                 },
@@ -2314,6 +4828,73 @@ const docTemplate = `{
                 }
             }
         },
+        "dangbamgong-backend_internal_dto.VoidRoomMember": {
+            "type": "object",
+            "properties": {
+                "elapsedSec": {
+                    "description": "공백 중일 때만",
+                    "type": "integer"
+                },
+                "isInVoid": {
+                    "description": "열린 방의 멤버만, 보는 사람도 멤버일 때만",
+                    "type": "boolean"
+                },
+                "isMember": {
+                    "description": "지금 방에 있는지. false 면 나간 유저",
+                    "type": "boolean"
+                },
+                "sessionCount": {
+                    "type": "integer"
+                },
+                "totalDurationSec": {
+                    "type": "integer"
+                },
+                "user": {
+                    "$ref": "#/definitions/dangbamgong-backend_internal_dto.UserSearchItem"
+                },
+                "voidStartedAt": {
+                    "description": "공백 중일 때만",
+                    "type": "string"
+                }
+            }
+        },
+        "dangbamgong-backend_internal_dto.VoidRoomResponse": {
+            "type": "object",
+            "properties": {
+                "closedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "maxMembers": {
+                    "type": "integer"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dangbamgong-backend_internal_dto.VoidRoomMember"
+                    }
+                },
+                "ownerId": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "sessionCount": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "OPEN, CLOSED",
+                    "type": "string"
+                },
+                "totalDurationSec": {
+                    "description": "방에 연결된 끝난 공백의 합. 닫힌 방은 닫힐 때의 합계",
+                    "type": "integer"
+                }
+            }
+        },
         "dangbamgong-backend_internal_dto.VoidSession": {
             "type": "object",
             "properties": {
@@ -2329,6 +4910,17 @@ const docTemplate = `{
                 "endedAt": {
                     "type": "string"
                 },
+                "reactionCounts": {
+                    "description": "이모지별 친구 반응 수",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "roomId": {
+                    "description": "함께 공백한 방, 없으면 null",
+                    "type": "string"
+                },
                 "sessionId": {
                     "type": "string"
                 },
@@ -2357,6 +4949,10 @@ const docTemplate = `{
         "dangbamgong-backend_internal_dto.VoidStartResponse": {
             "type": "object",
             "properties": {
+                "roomId": {
+                    "description": "들어가 있는 공백 방, 없으면 null",
+                    "type": "string"
+                },
                 "sessionId": {
                     "type": "string"
                 },
@@ -2367,6 +4963,15 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "dangbamgong-backend_internal_dto.WithdrawResponse": {
+            "type": "object",
+            "properties": {
+                "deleteAt": {
+                    "description": "이 시각 전에 다시 로그인하면 계정 복구",
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultAppleBaseURL      = "https://appleid.apple.com"
+	appleClientSecretTTL     = time.Hour
+	appleClientSecretRefresh = 5 * time.Minute // 만료 전 이 시간 안이면 새로 발급
+)
+
+type appleClaims struct {
+	Nonce string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+type appleVerifier struct {
+	client    *http.Client
+	jwks      *jwksCache
+	baseURL   string // issuer 겸 API 주소
+	clientIDs []string
+
+	// client secret 생성용 (revoke). privateKey 가 nil 이면 revoke 하지 않음
+	teamID         string
+	keyID          string
+	revokeClientID string
+	privateKey     *ecdsa.PrivateKey
+
+	mu              sync.Mutex
+	clientSecret    string
+	clientSecretExp time.Time
+}
+
+// APPLE_CLIENT_IDS(번들 ID / 서비스 ID, 쉼표 구분)가 없으면 nil 을 반환한다.
+// APPLE_TEAM_ID, APPLE_KEY_ID, APPLE_PRIVATE_KEY_PATH 가 모두 있어야 탈퇴 시 토큰을 revoke 한다.
+func newAppleVerifier(client *http.Client) *appleVerifier {
+	clientIDs := config.GetList("APPLE_CLIENT_IDS")
+	if len(clientIDs) == 0 {
+		log.Println("[AUTH] APPLE_CLIENT_IDS not configured, apple login disabled")
+		return nil
+	}
+
+	baseURL := strings.TrimRight(config.GetString("APPLE_BASE_URL", defaultAppleBaseURL), "/")
+	v := &appleVerifier{
+		client:         client,
+		jwks:           newJWKSCache(baseURL+"/auth/keys", client),
+		baseURL:        baseURL,
+		clientIDs:      clientIDs,
+		teamID:         os.Getenv("APPLE_TEAM_ID"),
+		keyID:          os.Getenv("APPLE_KEY_ID"),
+		revokeClientID: config.GetString("APPLE_REVOKE_CLIENT_ID", clientIDs[0]),
+	}
+
+	keyPath := os.Getenv("APPLE_PRIVATE_KEY_PATH")
+	if v.teamID == "" || v.keyID == "" || keyPath == "" {
+		log.Println("[AUTH] apple client secret not configured, token revocation disabled")
+		return v
+	}
+
+	key, err := loadApplePrivateKey(keyPath)
+	if err != nil {
+		log.Printf("[AUTH] failed to load apple private key: %v, token revocation disabled\n", err)
+		return v
+	}
+	v.privateKey = key
+	return v
+}
+
+func loadApplePrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.ParseECPrivateKeyFromPEM(data)
+}
+
+// verify 는 identity token 을 검증한다.
+// 가로챈 토큰을 재사용하지 못하도록 nonce 는 필수이며, 토큰의 nonce 가 클라이언트가 보낸 원본 nonce 의 SHA-256(hex)과 같아야 한다.
+func (v *appleVerifier) verify(ctx context.Context, idToken string, nonce string) (*SocialVerifyResult, error) {
+	claims := &appleClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, jwksKeyFunc(ctx, v.jwks),
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(v.baseURL),
+		jwt.WithAudience(v.clientIDs...),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "invalid apple identity token: "+err.Error())
+	}
+	if claims.Subject == "" {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "apple identity token has no subject")
+	}
+
+	if nonce == "" || claims.Nonce == "" {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "apple identity token nonce required")
+	}
+	hashed := sha256.Sum256([]byte(nonce))
+	expected := hex.EncodeToString(hashed[:])
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(expected)) != 1 {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "apple identity token nonce mismatch")
+	}
+
+	return &SocialVerifyResult{SocialID: claims.Subject}, nil
+}
+
+// revoke 는 저장된 refresh token 을 무효화한다.
+func (v *appleVerifier) revoke(ctx context.Context, refreshToken string) error {
+	if v.privateKey == nil {
+		return errors.New("apple client secret not configured")
+	}
+
+	secret, err := v.getClientSecret(time.Now())
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{
+		"client_id":       {v.revokeClientID},
+		"client_secret":   {secret},
+		"token":           {refreshToken},
+		"token_type_hint": {"refresh_token"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.baseURL+"/auth/revoke", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("apple revoke: status %d %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// getClientSecret 은 ES256 으로 서명한 client secret JWT 를 만료 전까지 재사용한다.
+func (v *appleVerifier) getClientSecret(now time.Time) (string, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.clientSecret != "" && now.Add(appleClientSecretRefresh).Before(v.clientSecretExp) {
+		return v.clientSecret, nil
+	}
+
+	exp := now.Add(appleClientSecretTTL)
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    v.teamID,
+		Subject:   v.revokeClientID,
+		Audience:  jwt.ClaimStrings{v.baseURL},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(exp),
+	})
+	token.Header["kid"] = v.keyID
+
+	secret, err := token.SignedString(v.privateKey)
+	if err != nil {
+		return "", err
+	}
+	v.clientSecret = secret
+	v.clientSecretExp = exp
+	return secret, nil
+}
@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type testAppleRevoke struct {
+	clientID     string
+	clientSecret string
+	token        string
+	hint         string
+}
+
+// newTestAppleServer 는 JWKS 와 revoke 엔드포인트를 제공하는 Apple 대역 서버를 띄운다.
+func newTestAppleServer(t *testing.T) (*testJWKSServer, *httptest.Server, chan testAppleRevoke) {
+	t.Helper()
+
+	keys := newTestJWKSServer(t, "apple-key")
+	revoked := make(chan testAppleRevoke, 1)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /auth/keys", keys.Config.Handler)
+	mux.HandleFunc("POST /auth/revoke", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		revoked <- testAppleRevoke{
+			clientID:     r.PostForm.Get("client_id"),
+			clientSecret: r.PostForm.Get("client_secret"),
+			token:        r.PostForm.Get("token"),
+			hint:         r.PostForm.Get("token_type_hint"),
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return keys, server, revoked
+}
+
+func writeTestAppleKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "AuthKey_TESTKEY.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	return key, path
+}
+
+func newTestAppleVerifier(t *testing.T) (*appleVerifier, *testJWKSServer, *httptest.Server, chan testAppleRevoke, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	keys, server, revoked := newTestAppleServer(t)
+	privateKey, keyPath := writeTestAppleKey(t)
+
+	t.Setenv("APPLE_CLIENT_IDS", "com.example.dangbamgong,com.example.dangbamgong.web")
+	t.Setenv("APPLE_BASE_URL", server.URL)
+	t.Setenv("APPLE_TEAM_ID", "TEAM123456")
+	t.Setenv("APPLE_KEY_ID", "TESTKEY")
+	t.Setenv("APPLE_PRIVATE_KEY_PATH", keyPath)
+	t.Setenv("APPLE_REVOKE_CLIENT_ID", "")
+
+	v := newAppleVerifier(server.Client())
+	if v == nil {
+		t.Fatal("expected apple verifier")
+	}
+	return v, keys, server, revoked, privateKey
+}
+
+func appleTestClaims(issuer string, rawNonce string) *appleClaims {
+	now := time.Now()
+	claims := &appleClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   "001234.abcdef.0123",
+			Audience:  jwt.ClaimStrings{"com.example.dangbamgong"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(10 * time.Minute)),
+		},
+	}
+	if rawNonce != "" {
+		hashed := sha256.Sum256([]byte(rawNonce))
+		claims.Nonce = hex.EncodeToString(hashed[:])
+	}
+	return claims
+}
+
+func TestAppleVerify(t *testing.T) {
+	v, keys, server, _, _ := newTestAppleVerifier(t)
+
+	token := keys.sign(t, "apple-key", appleTestClaims(server.URL, "raw-nonce"))
+	result, err := v.verify(context.Background(), token, "raw-nonce")
+	if err != nil {
+		t.Fatalf("expected valid token, got %v", err)
+	}
+	if result.SocialID != "001234.abcdef.0123" {
+		t.Fatalf("expected social id 001234.abcdef.0123, got %s", result.SocialID)
+	}
+}
+
+func TestAppleVerifyRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		nonce  string
+		modify func(c *appleClaims)
+	}{
+		{"nonce mismatch", "other-nonce", func(c *appleClaims) {}},
+		{"missing nonce", "", func(c *appleClaims) {}},
+		{"unexpected nonce", "raw-nonce", func(c *appleClaims) { c.Nonce = "" }},
+		{"no nonce", "", func(c *appleClaims) { c.Nonce = "" }},
+		{"wrong audience", "raw-nonce", func(c *appleClaims) { c.Audience = jwt.ClaimStrings{"com.example.other"} }},
+		{"wrong issuer", "raw-nonce", func(c *appleClaims) { c.Issuer = "https://appleid.example.com" }},
+		{"expired", "raw-nonce", func(c *appleClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, keys, server, _, _ := newTestAppleVerifier(t)
+			claims := appleTestClaims(server.URL, "raw-nonce")
+			tt.modify(claims)
+
+			if _, err := v.verify(context.Background(), keys.sign(t, "apple-key", claims), tt.nonce); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestAppleClientSecret(t *testing.T) {
+	v, _, server, _, privateKey := newTestAppleVerifier(t)
+
+	now := time.Now()
+	secret, err := v.getClientSecret(now)
+	if err != nil {
+		t.Fatalf("failed to create client secret: %v", err)
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	token, err := jwt.ParseWithClaims(secret, claims, func(t *jwt.Token) (interface{}, error) {
+		return &privateKey.PublicKey, nil
+	}, jwt.WithValidMethods([]string{"ES256"}))
+	if err != nil {
+		t.Fatalf("invalid client secret: %v", err)
+	}
+
+	if token.Header["kid"] != "TESTKEY" {
+		t.Errorf("expected kid TESTKEY, got %v", token.Header["kid"])
+	}
+	if claims.Issuer != "TEAM123456" {
+		t.Errorf("expected iss TEAM123456, got %s", claims.Issuer)
+	}
+	if claims.Subject != "com.example.dangbamgong" {
+		t.Errorf("expected sub com.example.dangbamgong, got %s", claims.Subject)
+	}
+	if len(claims.Audience) != 1 || claims.Audience[0] != server.URL {
+		t.Errorf("expected aud %s, got %v", server.URL, claims.Audience)
+	}
+
+	// 만료 전에는 같은 secret 재사용
+	again, err := v.getClientSecret(now.Add(time.Minute))
+	if err != nil || again != secret {
+		t.Error("expected cached client secret")
+	}
+}
+
+func TestAppleRevoke(t *testing.T) {
+	v, _, _, revoked, _ := newTestAppleVerifier(t)
+	social := &defaultSocialVerifier{providers: map[string]providerVerifier{"APPLE": v}}
+
+	if err := social.Revoke(context.Background(), "APPLE", "stored-refresh-token"); err != nil {
+		t.Fatalf("expected revoke to succeed, got %v", err)
+	}
+
+	select {
+	case r := <-revoked:
+		if r.token != "stored-refresh-token" || r.hint != "refresh_token" {
+			t.Errorf("unexpected revoke request: %+v", r)
+		}
+		if r.clientID != "com.example.dangbamgong" || r.clientSecret == "" {
+			t.Errorf("expected client credentials, got %+v", r)
+		}
+	default:
+		t.Fatal("expected revoke request")
+	}
+}
+
+func TestAppleRevokeWithoutClientSecret(t *testing.T) {
+	v, _, _, _, _ := newTestAppleVerifier(t)
+	v.privateKey = nil
+
+	if err := v.revoke(context.Background(), "stored-refresh-token"); err == nil {
+		t.Fatal("expected error without client secret")
+	}
+}
+
+func TestSocialRevokeIgnoresUnsupportedProvider(t *testing.T) {
+	social := &defaultSocialVerifier{providers: map[string]providerVerifier{}}
+	if err := social.Revoke(context.Background(), "KAKAO", "token"); err != nil {
+		t.Fatalf("expected no-op, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const defaultGoogleJWKSURL = "https://www.googleapis.com/oauth2/v3/certs"
+
+var googleIssuers = []string{"accounts.google.com", "https://accounts.google.com"}
+
+type googleClaims struct {
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"` // bool 또는 "true"/"false" 문자열
+	jwt.RegisteredClaims
+}
+
+type googleVerifier struct {
+	jwks      *jwksCache
+	clientIDs []string
+}
+
+// GOOGLE_CLIENT_IDS(쉼표 구분)가 없으면 nil 을 반환한다.
+func newGoogleVerifier(client *http.Client) *googleVerifier {
+	clientIDs := config.GetList("GOOGLE_CLIENT_IDS")
+	if len(clientIDs) == 0 {
+		log.Println("[AUTH] GOOGLE_CLIENT_IDS not configured, google login disabled")
+		return nil
+	}
+
+	return &googleVerifier{
+		jwks:      newJWKSCache(config.GetString("GOOGLE_JWKS_URL", defaultGoogleJWKSURL), client),
+		clientIDs: clientIDs,
+	}
+}
+
+func (v *googleVerifier) verify(ctx context.Context, idToken string, _ string) (*SocialVerifyResult, error) {
+	claims := &googleClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, jwksKeyFunc(ctx, v.jwks),
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithAudience(v.clientIDs...),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "invalid google id token: "+err.Error())
+	}
+
+	if !containsString(googleIssuers, claims.Issuer) {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "invalid google id token issuer")
+	}
+	if claims.Subject == "" {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "google id token has no subject")
+	}
+	if claims.Email != "" && !isTrue(claims.EmailVerified) {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "google email not verified")
+	}
+
+	return &SocialVerifyResult{SocialID: claims.Subject}, nil
+}
+
+// jwksKeyFunc 는 토큰 헤더의 kid 로 JWKS 에서 검증 키를 찾는다.
+func jwksKeyFunc(ctx context.Context, jwks *jwksCache) jwt.Keyfunc {
+	return func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if kid == "" {
+			return nil, jwt.ErrTokenUnverifiable
+		}
+		return jwks.Key(ctx, kid)
+	}
+}
+
+func isTrue(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return b == "true"
+	default:
+		return false
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
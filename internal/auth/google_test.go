@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestGoogleVerifier(t *testing.T) (*googleVerifier, *testJWKSServer) {
+	t.Helper()
+	server := newTestJWKSServer(t, "google-key")
+	t.Setenv("GOOGLE_CLIENT_IDS", "ios-client, android-client")
+	t.Setenv("GOOGLE_JWKS_URL", server.URL)
+
+	v := newGoogleVerifier(server.Client())
+	if v == nil {
+		t.Fatal("expected google verifier")
+	}
+	return v, server
+}
+
+func googleTestClaims() *googleClaims {
+	now := time.Now()
+	return &googleClaims{
+		Email:         "user@example.com",
+		EmailVerified: true,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "https://accounts.google.com",
+			Subject:   "1234567890",
+			Audience:  jwt.ClaimStrings{"android-client"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+	}
+}
+
+func TestGoogleVerify(t *testing.T) {
+	v, server := newTestGoogleVerifier(t)
+
+	result, err := v.verify(context.Background(), server.sign(t, "google-key", googleTestClaims()), "")
+	if err != nil {
+		t.Fatalf("expected valid token, got %v", err)
+	}
+	if result.SocialID != "1234567890" {
+		t.Fatalf("expected social id 1234567890, got %s", result.SocialID)
+	}
+}
+
+func TestGoogleVerifyEmailVerifiedString(t *testing.T) {
+	v, server := newTestGoogleVerifier(t)
+
+	claims := googleTestClaims()
+	claims.EmailVerified = "true"
+	if _, err := v.verify(context.Background(), server.sign(t, "google-key", claims), ""); err != nil {
+		t.Fatalf("expected valid token, got %v", err)
+	}
+}
+
+func TestGoogleVerifyRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *googleClaims)
+	}{
+		{"wrong audience", func(c *googleClaims) { c.Audience = jwt.ClaimStrings{"other-client"} }},
+		{"wrong issuer", func(c *googleClaims) { c.Issuer = "https://evil.example.com" }},
+		{"expired", func(c *googleClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour)) }},
+		{"missing exp", func(c *googleClaims) { c.ExpiresAt = nil }},
+		{"email not verified", func(c *googleClaims) { c.EmailVerified = false }},
+		{"missing subject", func(c *googleClaims) { c.Subject = "" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, server := newTestGoogleVerifier(t)
+			claims := googleTestClaims()
+			tt.modify(claims)
+
+			if _, err := v.verify(context.Background(), server.sign(t, "google-key", claims), ""); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestGoogleVerifyRejectsUnknownSigner(t *testing.T) {
+	v, _ := newTestGoogleVerifier(t)
+	other := newTestJWKSServer(t, "google-key")
+
+	if _, err := v.verify(context.Background(), other.sign(t, "google-key", googleTestClaims()), ""); err == nil {
+		t.Fatal("expected signature error")
+	}
+}
+
+func TestGoogleVerifyRejectsHS256(t *testing.T) {
+	v, _ := newTestGoogleVerifier(t)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, googleTestClaims())
+	token.Header["kid"] = "google-key"
+	signed, err := token.SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	if _, err := v.verify(context.Background(), signed, ""); err == nil {
+		t.Fatal("expected algorithm error")
+	}
+}
+
+func TestNewGoogleVerifierRequiresClientIDs(t *testing.T) {
+	t.Setenv("GOOGLE_CLIENT_IDS", "")
+	if v := newGoogleVerifier(nil); v != nil {
+		t.Fatal("expected nil verifier without client ids")
+	}
+}
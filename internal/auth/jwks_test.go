@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testJWKSServer 는 테스트용 RSA 키를 JWKS 로 제공하는 로컬 서버
+type testJWKSServer struct {
+	*httptest.Server
+	keys         map[string]*rsa.PrivateKey
+	cacheControl string
+	hits         atomic.Int32
+}
+
+func newTestJWKSServer(t *testing.T, kids ...string) *testJWKSServer {
+	t.Helper()
+
+	s := &testJWKSServer{keys: map[string]*rsa.PrivateKey{}, cacheControl: "public, max-age=3600"}
+	for _, kid := range kids {
+		s.addKey(t, kid)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.hits.Add(1)
+		var set JWKSet
+		for kid, key := range s.keys {
+			set.Keys = append(set.Keys, JWK{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				Alg: "RS256",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		w.Header().Set("Cache-Control", s.cacheControl)
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testJWKSServer) addKey(t *testing.T, kid string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	s.keys[kid] = key
+}
+
+func (s *testJWKSServer) sign(t *testing.T, kid string, claims jwt.Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(s.keys[kid])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func TestJWKSCacheUsesCacheControl(t *testing.T) {
+	server := newTestJWKSServer(t, "key-1")
+	cache := newJWKSCache(server.URL, server.Client())
+
+	for i := 0; i < 3; i++ {
+		if _, err := cache.Key(context.Background(), "key-1"); err != nil {
+			t.Fatalf("expected key, got %v", err)
+		}
+	}
+	if hits := server.hits.Load(); hits != 1 {
+		t.Fatalf("expected 1 fetch within max-age, got %d", hits)
+	}
+}
+
+func TestJWKSCacheRefetchesUnknownKid(t *testing.T) {
+	server := newTestJWKSServer(t, "key-1")
+	cache := newJWKSCache(server.URL, server.Client())
+
+	if _, err := cache.Key(context.Background(), "key-1"); err != nil {
+		t.Fatalf("expected key, got %v", err)
+	}
+
+	// 키 교체
+	server.addKey(t, "key-2")
+	cache.lastFetched = cache.lastFetched.Add(-jwksMinRefreshWait)
+
+	if _, err := cache.Key(context.Background(), "key-2"); err != nil {
+		t.Fatalf("expected rotated key, got %v", err)
+	}
+	if hits := server.hits.Load(); hits != 2 {
+		t.Fatalf("expected 2 fetches, got %d", hits)
+	}
+
+	// 짧은 시간 안에 모르는 kid 가 반복되어도 재조회하지 않음
+	if _, err := cache.Key(context.Background(), "unknown"); err == nil {
+		t.Fatal("expected error for unknown kid")
+	}
+	if hits := server.hits.Load(); hits != 2 {
+		t.Fatalf("expected no extra fetch, got %d", hits)
+	}
+}
+
+func TestCacheMaxAge(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"public, max-age=19845, must-revalidate, no-transform", "5h30m45s"},
+		{"max-age=60", "1m0s"},
+		{"no-cache", defaultJWKSMaxAge.String()},
+		{"", defaultJWKSMaxAge.String()},
+	}
+	for _, tt := range tests {
+		if got := cacheMaxAge(tt.header).String(); got != tt.want {
+			t.Errorf("cacheMaxAge(%q) = %s, want %s", tt.header, got, tt.want)
+		}
+	}
+}
@@ -1,42 +1,56 @@
 package auth
 
 import (
-	"log"
-	"os"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"time"
 
+	"dangbamgong-backend/internal/config"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func init() {
-	if os.Getenv("JWT_SECRET") == "" {
-		log.Fatal("JWT_SECRET environment variable is required")
-	}
-}
+var errNoKeys = errors.New("jwt keys not loaded")
 
 type Claims struct {
-	UserID string `json:"userId"`
+	UserID    string `json:"userId"`
+	SessionID string `json:"sid"`
 	jwt.RegisteredClaims
 }
 
-func GenerateToken(userID string) (string, error) {
-	secret := []byte(os.Getenv("JWT_SECRET"))
+// GenerateToken 은 ACCESS_TOKEN_TTL(기본 15분) 동안 유효한 액세스 토큰을 발급한다.
+func GenerateToken(userID string, sessionID string) (string, error) {
+	if keys == nil {
+		return "", errNoKeys
+	}
+	ttl := config.GetDuration("ACCESS_TOKEN_TTL", 15*time.Minute)
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+
 	claims := Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(30 * 24 * time.Hour)),
+			ID:        hex.EncodeToString(jti),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secret)
+	return keys.sign(claims)
 }
 
 func ParseToken(tokenString string) (*Claims, error) {
-	secret := []byte(os.Getenv("JWT_SECRET"))
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(t *jwt.Token) (interface{}, error) {
-		return secret, nil
-	})
+	if keys == nil {
+		return nil, errNoKeys
+	}
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, keys.keyFunc,
+		jwt.WithValidMethods(keys.allowedMethods()),
+		jwt.WithExpirationRequired(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultKakaoAuthBaseURL = "https://kauth.kakao.com"
+	defaultKakaoAPIBaseURL  = "https://kapi.kakao.com"
+)
+
+type kakaoTokenInfo struct {
+	ID        int64 `json:"id"`
+	ExpiresIn int64 `json:"expires_in"`
+	AppID     int64 `json:"app_id"`
+}
+
+// kakaoVerifier 는 OIDC ID 토큰을 우선 검증하고, JWT 가 아니면 액세스 토큰 정보 API 로 확인한다.
+type kakaoVerifier struct {
+	client     *http.Client
+	jwks       *jwksCache
+	issuer     string
+	apiBaseURL string
+	appKeys    []string // ID 토큰 aud (앱 키)
+	appID      int64    // 액세스 토큰 app_id, 0 이면 액세스 토큰은 거부
+}
+
+// KAKAO_APP_KEYS(ID 토큰)와 KAKAO_APP_ID(액세스 토큰)가 모두 없으면 nil 을 반환한다.
+func newKakaoVerifier(client *http.Client) *kakaoVerifier {
+	appKeys := config.GetList("KAKAO_APP_KEYS")
+	appID := int64(config.GetInt("KAKAO_APP_ID", 0))
+	if len(appKeys) == 0 && appID == 0 {
+		log.Println("[AUTH] KAKAO_APP_KEYS / KAKAO_APP_ID not configured, kakao login disabled")
+		return nil
+	}
+	if len(appKeys) == 0 {
+		log.Println("[AUTH] KAKAO_APP_KEYS not configured, kakao id tokens will be rejected")
+	}
+	if appID == 0 {
+		log.Println("[AUTH] KAKAO_APP_ID not configured, kakao access tokens will be rejected")
+	}
+
+	authBaseURL := strings.TrimRight(config.GetString("KAKAO_AUTH_BASE_URL", defaultKakaoAuthBaseURL), "/")
+	return &kakaoVerifier{
+		client:     client,
+		jwks:       newJWKSCache(authBaseURL+"/.well-known/jwks.json", client),
+		issuer:     authBaseURL,
+		apiBaseURL: strings.TrimRight(config.GetString("KAKAO_API_BASE_URL", defaultKakaoAPIBaseURL), "/"),
+		appKeys:    appKeys,
+		appID:      appID,
+	}
+}
+
+func (v *kakaoVerifier) verify(ctx context.Context, token string, _ string) (*SocialVerifyResult, error) {
+	if strings.Count(token, ".") == 2 {
+		return v.verifyIDToken(ctx, token)
+	}
+	return v.verifyAccessToken(ctx, token)
+}
+
+func (v *kakaoVerifier) verifyIDToken(ctx context.Context, idToken string) (*SocialVerifyResult, error) {
+	if len(v.appKeys) == 0 {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "kakao id token login not configured")
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, jwksKeyFunc(ctx, v.jwks),
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithAudience(v.appKeys...),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "invalid kakao id token: "+err.Error())
+	}
+	if claims.Subject == "" {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "kakao id token has no subject")
+	}
+
+	return &SocialVerifyResult{SocialID: claims.Subject}, nil
+}
+
+func (v *kakaoVerifier) verifyAccessToken(ctx context.Context, accessToken string) (*SocialVerifyResult, error) {
+	// 다른 앱에 발급된 토큰으로 로그인하지 못하도록 app_id 를 알 때만 허용
+	if v.appID == 0 {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "kakao access token login not configured")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.apiBaseURL+"/v1/user/access_token_info", nil)
+	if err != nil {
+		return nil, domain.NewInternal("failed to create kakao request: " + err.Error())
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, domain.NewInternal("failed to call kakao api: " + err.Error())
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusBadRequest:
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "invalid kakao access token")
+	case resp.StatusCode != http.StatusOK:
+		return nil, domain.NewInternal("kakao api returned status " + strconv.Itoa(resp.StatusCode))
+	}
+
+	var info kakaoTokenInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, domain.NewInternal("failed to decode kakao response: " + err.Error())
+	}
+	if info.ID == 0 {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "kakao token has no user id")
+	}
+	if info.AppID != v.appID {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "kakao token issued for another app")
+	}
+
+	return &SocialVerifyResult{SocialID: strconv.FormatInt(info.ID, 10)}, nil
+}
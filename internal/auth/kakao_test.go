@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// newTestKakaoAPI 는 액세스 토큰 정보 API 를 흉내 내는 서버를 띄운다.
+func newTestKakaoAPI(t *testing.T, tokens map[string]kakaoTokenInfo) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/user/access_token_info", func(w http.ResponseWriter, r *http.Request) {
+		info, ok := tokens[r.Header.Get("Authorization")]
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"msg":"this access token does not exist","code":-401}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(info)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestKakaoVerifier(t *testing.T, appKeys string, appID string) (*kakaoVerifier, *testJWKSServer) {
+	t.Helper()
+
+	auth := newTestJWKSServer(t, "kakao-key")
+	api := newTestKakaoAPI(t, map[string]kakaoTokenInfo{
+		"Bearer valid-token":     {ID: 4012345678, ExpiresIn: 3600, AppID: 1111},
+		"Bearer other-app-token": {ID: 4012345678, ExpiresIn: 3600, AppID: 2222},
+	})
+
+	t.Setenv("KAKAO_APP_KEYS", appKeys)
+	t.Setenv("KAKAO_APP_ID", appID)
+	t.Setenv("KAKAO_AUTH_BASE_URL", auth.URL)
+	t.Setenv("KAKAO_API_BASE_URL", api.URL)
+
+	v := newKakaoVerifier(&http.Client{Timeout: time.Second})
+	if v == nil {
+		t.Fatal("expected kakao verifier")
+	}
+	return v, auth
+}
+
+func kakaoTestClaims(issuer string) *jwt.RegisteredClaims {
+	now := time.Now()
+	return &jwt.RegisteredClaims{
+		Issuer:    issuer,
+		Subject:   "4012345678",
+		Audience:  jwt.ClaimStrings{"native-app-key"},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+}
+
+func TestKakaoVerifyIDToken(t *testing.T) {
+	v, auth := newTestKakaoVerifier(t, "native-app-key", "1111")
+
+	result, err := v.verify(context.Background(), auth.sign(t, "kakao-key", kakaoTestClaims(auth.URL)), "")
+	if err != nil {
+		t.Fatalf("expected valid token, got %v", err)
+	}
+	if result.SocialID != "4012345678" {
+		t.Fatalf("expected social id 4012345678, got %s", result.SocialID)
+	}
+}
+
+func TestKakaoVerifyIDTokenRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *jwt.RegisteredClaims)
+	}{
+		{"wrong audience", func(c *jwt.RegisteredClaims) { c.Audience = jwt.ClaimStrings{"other-app-key"} }},
+		{"wrong issuer", func(c *jwt.RegisteredClaims) { c.Issuer = "https://kauth.example.com" }},
+		{"expired", func(c *jwt.RegisteredClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour)) }},
+		{"missing subject", func(c *jwt.RegisteredClaims) { c.Subject = "" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, auth := newTestKakaoVerifier(t, "native-app-key", "1111")
+			claims := kakaoTestClaims(auth.URL)
+			tt.modify(claims)
+
+			if _, err := v.verify(context.Background(), auth.sign(t, "kakao-key", claims), ""); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestKakaoVerifyIDTokenWithoutAppKeys(t *testing.T) {
+	v, auth := newTestKakaoVerifier(t, "", "1111")
+
+	if _, err := v.verify(context.Background(), auth.sign(t, "kakao-key", kakaoTestClaims(auth.URL)), ""); err == nil {
+		t.Fatal("expected id token to be rejected without app keys")
+	}
+}
+
+func TestKakaoVerifyAccessToken(t *testing.T) {
+	v, _ := newTestKakaoVerifier(t, "native-app-key", "1111")
+
+	result, err := v.verify(context.Background(), "valid-token", "")
+	if err != nil {
+		t.Fatalf("expected valid token, got %v", err)
+	}
+	if result.SocialID != "4012345678" {
+		t.Fatalf("expected social id 4012345678, got %s", result.SocialID)
+	}
+
+	if _, err := v.verify(context.Background(), "unknown-token", ""); err == nil {
+		t.Fatal("expected error for unknown token")
+	}
+
+	// app id 를 설정하지 않으면 액세스 토큰은 받지 않음
+	v, _ = newTestKakaoVerifier(t, "native-app-key", "")
+	if _, err := v.verify(context.Background(), "valid-token", ""); err == nil {
+		t.Fatal("expected access token to be rejected without app id")
+	}
+}
+
+func TestKakaoVerifyAccessTokenAppCheck(t *testing.T) {
+	v, _ := newTestKakaoVerifier(t, "native-app-key", "1111")
+	if _, err := v.verify(context.Background(), "other-app-token", ""); err == nil {
+		t.Fatal("expected error for token issued to another app")
+	}
+}
+
+func TestNewKakaoVerifierRequiresConfig(t *testing.T) {
+	t.Setenv("KAKAO_APP_KEYS", "")
+	t.Setenv("KAKAO_APP_ID", "")
+	if v := newKakaoVerifier(nil); v != nil {
+		t.Fatal("expected nil verifier without app keys")
+	}
+}
@@ -0,0 +1,235 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"dangbamgong-backend/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type verificationKey struct {
+	method jwt.SigningMethod
+	key    crypto.PublicKey
+}
+
+// keySet 은 액세스 토큰 서명 키와 검증 키 목록이다.
+// 키 교체 중에는 새 키로 서명하고, 이전 키는 만료 전 토큰 검증용으로만 남겨 둔다.
+type keySet struct {
+	signingKID    string
+	signingMethod jwt.SigningMethod
+	signingKey    crypto.PrivateKey
+	verifyKeys    map[string]verificationKey // kid → 공개 키
+	hmacSecret    []byte                     // kid 없는 HS256 토큰 (비대칭 키 전환 전 방식)
+}
+
+var keys *keySet
+
+// LoadKeys 는 환경변수에서 서명/검증 키를 읽는다. 서버 시작 시 한 번 호출한다.
+//   - JWT_SIGNING_KEY_PATH, JWT_SIGNING_KEY_ID: PEM 개인 키 (EC P-256 → ES256, Ed25519 → EdDSA)
+//   - JWT_VERIFICATION_KEYS: 이전 키 목록 "kid=경로,kid=경로" (PEM 공개 키 또는 개인 키)
+//   - JWT_SECRET: 비대칭 키가 없으면 HS256 으로 서명, 있으면 기존 HS256 토큰 검증에만 사용
+func LoadKeys() error {
+	ks, err := loadKeySet()
+	if err != nil {
+		return err
+	}
+	keys = ks
+	return nil
+}
+
+func loadKeySet() (*keySet, error) {
+	ks := &keySet{verifyKeys: map[string]verificationKey{}}
+	if secret := os.Getenv("JWT_SECRET"); secret != "" {
+		ks.hmacSecret = []byte(secret)
+	}
+
+	if path := os.Getenv("JWT_SIGNING_KEY_PATH"); path != "" {
+		kid := os.Getenv("JWT_SIGNING_KEY_ID")
+		if kid == "" {
+			return nil, errors.New("JWT_SIGNING_KEY_ID is required with JWT_SIGNING_KEY_PATH")
+		}
+		private, err := readPrivateKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("signing key: %w", err)
+		}
+		method, public, err := keyMethod(private)
+		if err != nil {
+			return nil, fmt.Errorf("signing key: %w", err)
+		}
+		ks.signingKID = kid
+		ks.signingMethod = method
+		ks.signingKey = private
+		ks.verifyKeys[kid] = verificationKey{method: method, key: public}
+	}
+
+	for _, entry := range config.GetList("JWT_VERIFICATION_KEYS") {
+		kid, path, ok := strings.Cut(entry, "=")
+		if !ok || kid == "" || path == "" {
+			return nil, fmt.Errorf("invalid JWT_VERIFICATION_KEYS entry %q", entry)
+		}
+		key, err := readKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("verification key %s: %w", kid, err)
+		}
+		method, public, err := keyMethod(key)
+		if err != nil {
+			return nil, fmt.Errorf("verification key %s: %w", kid, err)
+		}
+		if _, exists := ks.verifyKeys[kid]; !exists {
+			ks.verifyKeys[kid] = verificationKey{method: method, key: public}
+		}
+	}
+
+	if ks.signingKey == nil && ks.hmacSecret == nil {
+		return nil, errors.New("JWT_SIGNING_KEY_PATH or JWT_SECRET environment variable is required")
+	}
+	if ks.signingKey == nil {
+		log.Println("[AUTH] asymmetric signing key not configured, signing tokens with HS256")
+	} else {
+		log.Printf("[AUTH] signing tokens with %s key %s (%d verification keys)\n", ks.signingMethod.Alg(), ks.signingKID, len(ks.verifyKeys))
+	}
+	return ks, nil
+}
+
+// allowedMethods 는 검증에 허용할 알고리즘 목록이다. 여기 없는 alg(none 포함)는 거부된다.
+func (ks *keySet) allowedMethods() []string {
+	methods := []string{}
+	seen := map[string]bool{}
+	for _, k := range ks.verifyKeys {
+		if alg := k.method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	if ks.hmacSecret != nil {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	return methods
+}
+
+// keyFunc 는 kid 로 검증 키를 찾고, 토큰의 alg 가 해당 키의 알고리즘과 같은지 확인한다.
+func (ks *keySet) keyFunc(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		if ks.hmacSecret == nil || t.Method != jwt.SigningMethodHS256 {
+			return nil, jwt.ErrTokenUnverifiable
+		}
+		return ks.hmacSecret, nil
+	}
+
+	k, ok := ks.verifyKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if t.Method.Alg() != k.method.Alg() {
+		return nil, jwt.ErrTokenSignatureInvalid
+	}
+	return k.key, nil
+}
+
+func (ks *keySet) sign(claims jwt.Claims) (string, error) {
+	if ks.signingKey == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(ks.hmacSecret)
+	}
+	token := jwt.NewWithClaims(ks.signingMethod, claims)
+	token.Header["kid"] = ks.signingKID
+	return token.SignedString(ks.signingKey)
+}
+
+// PublicJWKS 는 다른 서비스가 토큰을 검증할 수 있도록 공개 키를 JWKS 형식으로 반환한다.
+func PublicJWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	if keys == nil {
+		return set
+	}
+	for kid, k := range keys.verifyKeys {
+		if j, ok := publicJWK(kid, k); ok {
+			set.Keys = append(set.Keys, j)
+		}
+	}
+	return set
+}
+
+func publicJWK(kid string, k verificationKey) (JWK, bool) {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch pub := k.key.(type) {
+	case *ecdsa.PublicKey:
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		return JWK{
+			Kty: "EC", Kid: kid, Use: "sig", Alg: k.method.Alg(), Crv: pub.Curve.Params().Name,
+			X: enc(pub.X.FillBytes(make([]byte, size))),
+			Y: enc(pub.Y.FillBytes(make([]byte, size))),
+		}, true
+	case ed25519.PublicKey:
+		return JWK{Kty: "OKP", Kid: kid, Use: "sig", Alg: k.method.Alg(), Crv: "Ed25519", X: enc(pub)}, true
+	default:
+		return JWK{}, false
+	}
+}
+
+// keyMethod 는 키 종류에 맞는 서명 알고리즘과 공개 키를 반환한다.
+func keyMethod(key any) (jwt.SigningMethod, crypto.PublicKey, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		return keyMethod(&k.PublicKey)
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return nil, nil, errors.New("only P-256 ec keys are supported")
+		}
+		return jwt.SigningMethodES256, k, nil
+	case ed25519.PrivateKey:
+		return jwt.SigningMethodEdDSA, k.Public(), nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, k, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+func readPrivateKey(path string) (crypto.PrivateKey, error) {
+	key, err := readKey(path)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *ecdsa.PrivateKey, ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, errors.New("not a private key")
+	}
+}
+
+// readKey 는 PEM 파일에서 개인 키(PKCS8, SEC1) 또는 공개 키(PKIX)를 읽는다.
+func readKey(path string) (any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid pem")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem type %q", block.Type)
+	}
+}
@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// writeTestSigningKey 는 PKCS8 PEM 개인 키 파일을 만든다.
+func writeTestSigningKey(t *testing.T, key crypto.PrivateKey) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "signing.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	return path
+}
+
+func newTestECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+// loadTestKeys 는 현재 환경변수로 키를 읽고, 테스트가 끝나면 이전 키로 되돌린다.
+func loadTestKeys(t *testing.T) {
+	t.Helper()
+
+	prev := keys
+	t.Cleanup(func() { keys = prev })
+	if err := LoadKeys(); err != nil {
+		t.Fatalf("LoadKeys() error: %v", err)
+	}
+}
+
+func setTestKeyEnv(t *testing.T, kid string, path string, verification string, secret string) {
+	t.Helper()
+	t.Setenv("JWT_SIGNING_KEY_ID", kid)
+	t.Setenv("JWT_SIGNING_KEY_PATH", path)
+	t.Setenv("JWT_VERIFICATION_KEYS", verification)
+	t.Setenv("JWT_SECRET", secret)
+}
+
+func tokenHeader(t *testing.T, token string) map[string]interface{} {
+	t.Helper()
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &Claims{})
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	return parsed.Header
+}
+
+func TestGenerateAndParseToken(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		key  crypto.PrivateKey
+		alg  string
+	}{
+		{"ES256", newTestECKey(t), "ES256"},
+		{"EdDSA", edKey, "EdDSA"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setTestKeyEnv(t, "key-1", writeTestSigningKey(t, tt.key), "", "")
+			loadTestKeys(t)
+
+			token, err := GenerateToken("user-1", "session-1")
+			if err != nil {
+				t.Fatalf("GenerateToken() error: %v", err)
+			}
+			header := tokenHeader(t, token)
+			if header["alg"] != tt.alg || header["kid"] != "key-1" {
+				t.Errorf("header = %v, want alg %s kid key-1", header, tt.alg)
+			}
+
+			claims, err := ParseToken(token)
+			if err != nil {
+				t.Fatalf("ParseToken() error: %v", err)
+			}
+			if claims.UserID != "user-1" || claims.SessionID != "session-1" {
+				t.Errorf("claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldPath := writeTestSigningKey(t, newTestECKey(t))
+	setTestKeyEnv(t, "old", oldPath, "", "")
+	loadTestKeys(t)
+	oldToken, err := GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken() error: %v", err)
+	}
+
+	// 새 키로 서명하고 이전 키는 검증용으로만 남긴다
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	setTestKeyEnv(t, "new", writeTestSigningKey(t, edKey), "old="+oldPath, "")
+	loadTestKeys(t)
+
+	if _, err := ParseToken(oldToken); err != nil {
+		t.Errorf("token signed with old key should still verify: %v", err)
+	}
+	newToken, err := GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken() error: %v", err)
+	}
+	if kid := tokenHeader(t, newToken)["kid"]; kid != "new" {
+		t.Errorf("kid = %v, want new", kid)
+	}
+
+	set := PublicJWKS()
+	if len(set.Keys) != 2 {
+		t.Fatalf("jwks has %d keys, want 2", len(set.Keys))
+	}
+	for _, k := range set.Keys {
+		if _, err := k.publicKey(); err != nil {
+			t.Errorf("published key %s is not usable: %v", k.Kid, err)
+		}
+	}
+
+	// 검증 목록에서 빠진 키로 서명된 토큰은 거부
+	setTestKeyEnv(t, "new", writeTestSigningKey(t, edKey), "", "")
+	loadTestKeys(t)
+	if _, err := ParseToken(oldToken); err == nil {
+		t.Error("token signed with retired key should be rejected")
+	}
+}
+
+func TestParseTokenRejectsUnexpectedAlgorithms(t *testing.T) {
+	ecKey := newTestECKey(t)
+	setTestKeyEnv(t, "key-1", writeTestSigningKey(t, ecKey), "", "")
+	loadTestKeys(t)
+
+	claims := &Claims{
+		UserID:    "user-1",
+		SessionID: "session-1",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	sign := func(method jwt.SigningMethod, kid string, key interface{}) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("failed to sign: %v", err)
+		}
+		return s
+	}
+	pub, _ := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+
+	tests := map[string]string{
+		"none":                   sign(jwt.SigningMethodNone, "key-1", jwt.UnsafeAllowNoneSignatureType),
+		"HS256 with public key":  sign(jwt.SigningMethodHS256, "key-1", pub),
+		"HS256 without secret":   sign(jwt.SigningMethodHS256, "", []byte("secret")),
+		"ES256 with unknown kid": sign(jwt.SigningMethodES256, "key-2", ecKey),
+		"ES256 without kid":      sign(jwt.SigningMethodES256, "", ecKey),
+		"ES256 with other key":   sign(jwt.SigningMethodES256, "key-1", newTestECKey(t)),
+	}
+	for name, token := range tests {
+		if _, err := ParseToken(token); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLegacyHS256Token(t *testing.T) {
+	setTestKeyEnv(t, "", "", "", "legacy-secret")
+	loadTestKeys(t)
+
+	legacy, err := GenerateToken("user-1", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken() error: %v", err)
+	}
+	if header := tokenHeader(t, legacy); header["alg"] != "HS256" || header["kid"] != nil {
+		t.Errorf("header = %v, want HS256 without kid", header)
+	}
+	if len(PublicJWKS().Keys) != 0 {
+		t.Error("hmac secret must not be published")
+	}
+
+	// 비대칭 키로 전환해도 JWT_SECRET 이 남아 있으면 기존 토큰은 유효
+	setTestKeyEnv(t, "key-1", writeTestSigningKey(t, newTestECKey(t)), "", "legacy-secret")
+	loadTestKeys(t)
+	if _, err := ParseToken(legacy); err != nil {
+		t.Errorf("legacy token should verify during migration: %v", err)
+	}
+	token, _ := GenerateToken("user-1", "session-1")
+	if alg := tokenHeader(t, token)["alg"]; alg != "ES256" {
+		t.Errorf("alg = %v, want ES256", alg)
+	}
+}
+
+func TestLoadKeysRequiresKey(t *testing.T) {
+	setTestKeyEnv(t, "", "", "", "")
+	if err := LoadKeys(); err == nil {
+		t.Error("expected error without any key")
+	}
+
+	setTestKeyEnv(t, "", writeTestSigningKey(t, newTestECKey(t)), "", "")
+	if err := LoadKeys(); err == nil {
+		t.Error("expected error without key id")
+	}
+}
@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"dangbamgong-backend/internal/domain"
 )
@@ -11,16 +13,54 @@ type SocialVerifyResult struct {
 }
 
 type SocialVerifier interface {
-	Verify(ctx context.Context, provider string, idToken string) (*SocialVerifyResult, error)
+	Verify(ctx context.Context, provider string, idToken string, nonce string) (*SocialVerifyResult, error)
+	// Revoke 는 탈퇴 시 소셜 계정 연결을 해제한다. 지원하지 않는 제공자는 아무것도 하지 않는다.
+	Revoke(ctx context.Context, provider string, token string) error
 }
 
-type defaultSocialVerifier struct{}
+// providerVerifier 는 소셜 제공자별 토큰 검증기
+type providerVerifier interface {
+	verify(ctx context.Context, idToken string, nonce string) (*SocialVerifyResult, error)
+}
+
+// providerRevoker 는 토큰 revoke 를 지원하는 제공자
+type providerRevoker interface {
+	revoke(ctx context.Context, token string) error
+}
+
+type defaultSocialVerifier struct {
+	providers map[string]providerVerifier
+}
 
+// 환경변수가 설정된 제공자만 활성화한다.
 func NewSocialVerifier() SocialVerifier {
-	return &defaultSocialVerifier{}
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	v := &defaultSocialVerifier{providers: map[string]providerVerifier{}}
+	if google := newGoogleVerifier(client); google != nil {
+		v.providers["GOOGLE"] = google
+	}
+	if kakao := newKakaoVerifier(client); kakao != nil {
+		v.providers["KAKAO"] = kakao
+	}
+	if apple := newAppleVerifier(client); apple != nil {
+		v.providers["APPLE"] = apple
+	}
+	return v
+}
+
+func (v *defaultSocialVerifier) Verify(ctx context.Context, provider string, idToken string, nonce string) (*SocialVerifyResult, error) {
+	p, ok := v.providers[provider]
+	if !ok {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "social login not configured for "+provider)
+	}
+	return p.verify(ctx, idToken, nonce)
 }
 
-func (v *defaultSocialVerifier) Verify(ctx context.Context, provider string, idToken string) (*SocialVerifyResult, error) {
-	// TODO: implement GOOGLE, KAKAO, APPLE verification
-	return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "social login not yet implemented for "+provider)
+func (v *defaultSocialVerifier) Revoke(ctx context.Context, provider string, token string) error {
+	r, ok := v.providers[provider].(providerRevoker)
+	if !ok || token == "" {
+		return nil
+	}
+	return r.revoke(ctx, token)
 }
@@ -15,3 +15,9 @@ func CalcTargetDay(t time.Time) string {
 	}
 	return kst.Format("2006-01-02")
 }
+
+// 주어진 시간 이후 다음 대상 날짜가 시작되는 시각 (다음 16시 KST)
+func NextDayStart(t time.Time) time.Time {
+	day, _ := time.ParseInLocation("2006-01-02", CalcTargetDay(t), KST)
+	return day.AddDate(0, 0, 1).Add(DayStartHour * time.Hour)
+}
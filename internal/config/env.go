@@ -0,0 +1,71 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetDuration 은 환경변수를 time.Duration 으로 읽는다 (예: "720h", "30m").
+// 값이 없거나 형식이 잘못되면 기본값을 반환한다.
+func GetDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Printf("[CONFIG] invalid duration for %s: %q, using default %v\n", key, v, fallback)
+		return fallback
+	}
+	return d
+}
+
+// GetInt 는 환경변수를 정수로 읽는다. 값이 없거나 형식이 잘못되면 기본값을 반환한다.
+func GetInt(key string, fallback int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		log.Printf("[CONFIG] invalid integer for %s: %q, using default %d\n", key, v, fallback)
+		return fallback
+	}
+	return n
+}
+
+// GetString 은 환경변수를 읽는다. 값이 없으면 기본값을 반환한다.
+func GetString(key string, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// GetList 는 쉼표로 구분된 환경변수를 읽는다 (예: "a,b,c"). 빈 항목은 무시한다.
+func GetList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// GetBool 은 환경변수를 bool 로 읽는다 (예: "true", "1"). 값이 없거나 형식이 잘못되면 기본값을 반환한다.
+func GetBool(key string, fallback bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Printf("[CONFIG] invalid bool for %s: %q, using default %t\n", key, v, fallback)
+		return fallback
+	}
+	return b
+}
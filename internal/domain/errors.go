@@ -1,6 +1,9 @@
 package domain
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 type ErrorCode string
 
@@ -13,6 +16,8 @@ const (
 	ErrForbidden          ErrorCode = "FORBIDDEN"
 	ErrConflict           ErrorCode = "CONFLICT"
 	ErrServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
+	ErrRateLimited        ErrorCode = "RATE_LIMITED"
+	ErrInvalidCursor      ErrorCode = "INVALID_CURSOR"
 )
 
 // Auth
@@ -20,13 +25,34 @@ const (
 	ErrInvalidToken       ErrorCode = "INVALID_TOKEN"
 	ErrInvalidNickname    ErrorCode = "INVALID_NICKNAME"
 	ErrNicknameAlreadySet ErrorCode = "NICKNAME_ALREADY_SET"
+	ErrNicknameNotAllowed ErrorCode = "NICKNAME_NOT_ALLOWED"
+	ErrRefreshTokenReused ErrorCode = "REFRESH_TOKEN_REUSED"
+	ErrAccountSuspended   ErrorCode = "ACCOUNT_SUSPENDED"
+	ErrIdentityLinked     ErrorCode = "IDENTITY_ALREADY_LINKED"
+	ErrProviderLinked     ErrorCode = "PROVIDER_ALREADY_LINKED"
+	ErrIdentityNotFound   ErrorCode = "IDENTITY_NOT_FOUND"
+	ErrLastIdentity       ErrorCode = "LAST_IDENTITY"
 )
 
 // User
 const (
-	ErrUserNotFound   ErrorCode = "USER_NOT_FOUND"
-	ErrAlreadyBlocked ErrorCode = "ALREADY_BLOCKED"
-	ErrNotBlocked     ErrorCode = "NOT_BLOCKED"
+	ErrUserNotFound           ErrorCode = "USER_NOT_FOUND"
+	ErrAlreadyBlocked         ErrorCode = "ALREADY_BLOCKED"
+	ErrNotBlocked             ErrorCode = "NOT_BLOCKED"
+	ErrNicknameChangeCooldown ErrorCode = "NICKNAME_CHANGE_COOLDOWN"
+	ErrInvalidTag             ErrorCode = "INVALID_TAG"
+	ErrTagAlreadyTaken        ErrorCode = "TAG_ALREADY_TAKEN"
+	ErrTagChangeCooldown      ErrorCode = "TAG_CHANGE_COOLDOWN"
+	ErrInvalidImage           ErrorCode = "INVALID_IMAGE"
+	ErrImageTooLarge          ErrorCode = "IMAGE_TOO_LARGE"
+	ErrInvalidPrivacySetting  ErrorCode = "INVALID_PRIVACY_SETTING"
+)
+
+// Export
+const (
+	ErrExportNotFound      ErrorCode = "EXPORT_NOT_FOUND"
+	ErrExportRateLimited   ErrorCode = "EXPORT_RATE_LIMITED"
+	ErrInvalidDownloadLink ErrorCode = "INVALID_DOWNLOAD_LINK"
 )
 
 // Void
@@ -34,6 +60,16 @@ const (
 	ErrAlreadyInVoid     ErrorCode = "ALREADY_IN_VOID"
 	ErrNotInVoid         ErrorCode = "NOT_IN_VOID"
 	ErrTooManyActivities ErrorCode = "TOO_MANY_ACTIVITIES"
+	ErrSessionNotFound   ErrorCode = "SESSION_NOT_FOUND"
+	ErrInvalidReaction   ErrorCode = "INVALID_REACTION"
+)
+
+// Void Room
+const (
+	ErrRoomNotFound         ErrorCode = "ROOM_NOT_FOUND"
+	ErrAlreadyInRoom        ErrorCode = "ALREADY_IN_ROOM"
+	ErrRoomFull             ErrorCode = "ROOM_FULL"
+	ErrRoomInviteeNotFriend ErrorCode = "ROOM_INVITEE_NOT_FRIEND"
 )
 
 // Activity
@@ -50,26 +86,67 @@ const (
 
 // Friend
 const (
-	ErrAlreadyFriends     ErrorCode = "ALREADY_FRIENDS"
-	ErrRequestAlreadySent ErrorCode = "REQUEST_ALREADY_SENT"
-	ErrBlocked            ErrorCode = "BLOCKED"
-	ErrRequestNotFound    ErrorCode = "REQUEST_NOT_FOUND"
-	ErrRequestNotPending  ErrorCode = "REQUEST_NOT_PENDING"
-	ErrNotFriends         ErrorCode = "NOT_FRIENDS"
-	ErrFriendNotInVoid    ErrorCode = "FRIEND_NOT_IN_VOID"
-	ErrInvalidRequestType ErrorCode = "INVALID_REQUEST_TYPE"
+	ErrAlreadyFriends       ErrorCode = "ALREADY_FRIENDS"
+	ErrRequestAlreadySent   ErrorCode = "REQUEST_ALREADY_SENT"
+	ErrBlocked              ErrorCode = "BLOCKED"
+	ErrRequestNotFound      ErrorCode = "REQUEST_NOT_FOUND"
+	ErrRequestNotPending    ErrorCode = "REQUEST_NOT_PENDING"
+	ErrNotFriends           ErrorCode = "NOT_FRIENDS"
+	ErrFriendNotInVoid      ErrorCode = "FRIEND_NOT_IN_VOID"
+	ErrInvalidRequestType   ErrorCode = "INVALID_REQUEST_TYPE"
+	ErrInvalidRequestStatus ErrorCode = "INVALID_REQUEST_STATUS"
+	ErrInvalidFriendSort    ErrorCode = "INVALID_FRIEND_SORT"
+	ErrRequestCooldown      ErrorCode = "FRIEND_REQUEST_COOLDOWN"
+	ErrNudgeCooldown        ErrorCode = "NUDGE_COOLDOWN"
+	ErrNudgeDailyLimit      ErrorCode = "NUDGE_DAILY_LIMIT"
+	ErrInviteNotFound       ErrorCode = "INVITE_NOT_FOUND"
+	ErrInviteExpired        ErrorCode = "INVITE_EXPIRED"
+	ErrInviteExhausted      ErrorCode = "INVITE_EXHAUSTED"
+)
+
+// Circle
+const (
+	ErrCircleNotFound        ErrorCode = "CIRCLE_NOT_FOUND"
+	ErrInvalidCircleName     ErrorCode = "INVALID_CIRCLE_NAME"
+	ErrTooManyCircles        ErrorCode = "TOO_MANY_CIRCLES"
+	ErrCircleNotDeletable    ErrorCode = "CIRCLE_NOT_DELETABLE"
+	ErrCircleInUse           ErrorCode = "CIRCLE_IN_USE"
+	ErrCircleMemberNotFriend ErrorCode = "CIRCLE_MEMBER_NOT_FRIEND"
 )
 
 type AppError struct {
 	StatusCode int
 	Code       ErrorCode
 	Message    string
+	Data       any           // 클라이언트에 함께 전달할 부가 정보 (없으면 nil)
+	RetryAfter time.Duration // 0 보다 크면 Retry-After 헤더로 전달
 }
 
 func (e *AppError) Error() string {
 	return e.Message
 }
 
+func (e *AppError) WithData(data any) *AppError {
+	e.Data = data
+	return e
+}
+
+// WithRetryAfter 는 다시 시도할 수 있을 때까지 남은 시간을 Retry-After 헤더와 data.retryAfterSec 로 전달한다.
+func (e *AppError) WithRetryAfter(d time.Duration) *AppError {
+	e.RetryAfter = d
+	e.Data = map[string]int64{"retryAfterSec": RetryAfterSeconds(d)}
+	return e
+}
+
+// RetryAfterSeconds 는 남은 시간을 초 단위로 올림한다. 최소 1초.
+func RetryAfterSeconds(d time.Duration) int64 {
+	sec := int64((d + time.Second - 1) / time.Second)
+	if sec < 1 {
+		return 1
+	}
+	return sec
+}
+
 func NewBadRequest(code ErrorCode, message string) *AppError {
 	return &AppError{
 		StatusCode: http.StatusBadRequest,
@@ -110,6 +187,22 @@ func NewConflict(code ErrorCode, message string) *AppError {
 	}
 }
 
+func NewPayloadTooLarge(code ErrorCode, message string) *AppError {
+	return &AppError{
+		StatusCode: http.StatusRequestEntityTooLarge,
+		Code:       code,
+		Message:    message,
+	}
+}
+
+func NewTooManyRequests(code ErrorCode, message string) *AppError {
+	return &AppError{
+		StatusCode: http.StatusTooManyRequests,
+		Code:       code,
+		Message:    message,
+	}
+}
+
 func NewInternal(message string) *AppError {
 	return &AppError{
 		StatusCode: http.StatusInternalServerError,
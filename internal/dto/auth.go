@@ -1,20 +1,86 @@
 package dto
 
+import "time"
+
 // POST /auth/login
 type LoginRequest struct {
-	Provider          string  `json:"provider" validate:"required,oneof=GOOGLE KAKAO APPLE"`
-	IDToken           string  `json:"idToken" validate:"required"`
-	AppleRefreshToken *string `json:"appleRefreshToken"`
+	Provider          string     `json:"provider" validate:"required,oneof=GOOGLE KAKAO APPLE"`
+	IDToken           string     `json:"idToken" validate:"required"` // KAKAO 는 OIDC ID 토큰 또는 액세스 토큰
+	Nonce             string     `json:"nonce"`                       // APPLE 필수: identity token 요청에 사용한 원본 nonce (토큰에는 SHA-256 hex)
+	AppleRefreshToken *string    `json:"appleRefreshToken"`
+	Device            DeviceInfo `json:"device"`
+}
+
+// 세션 목록에 표시할 기기 정보
+type DeviceInfo struct {
+	Name       string `json:"name"`     // 예: "iPhone 15 Pro"
+	Platform   string `json:"platform"` // 예: "iOS 18.1"
+	AppVersion string `json:"appVersion"`
+	UserAgent  string `json:"-"` // 핸들러에서 요청 헤더로 채움
+	IP         string `json:"-"`
 }
 
 type LoginResponse struct {
-	AccessToken string `json:"accessToken"`
-	IsNewUser   bool   `json:"isNewUser"`
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	IsNewUser    bool   `json:"isNewUser"`
+	IsRestored   bool   `json:"isRestored"` // 탈퇴 유예 중인 계정이 복구됨
+}
+
+// POST /auth/refresh
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+type RefreshResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"` // 사용한 refresh token 은 폐기되므로 반드시 교체
 }
 
 // POST /auth/login/test
 type TestLoginRequest struct {
-	SocialID string `json:"socialId" validate:"required"`
+	SocialID string     `json:"socialId" validate:"required"`
+	Device   DeviceInfo `json:"device"`
+}
+
+// GET /auth/sessions
+type SessionListResponse struct {
+	Sessions []SessionItem `json:"sessions"`
+}
+
+type SessionItem struct {
+	SessionID  string    `json:"sessionId"`
+	Provider   string    `json:"provider"`
+	DeviceName string    `json:"deviceName"`
+	Platform   string    `json:"platform"`
+	AppVersion string    `json:"appVersion"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	IsCurrent  bool      `json:"isCurrent"` // 현재 요청의 세션
+}
+
+// DELETE /auth/withdraw
+type WithdrawResponse struct {
+	DeleteAt time.Time `json:"deleteAt"` // 이 시각 전에 다시 로그인하면 계정 복구
+}
+
+// GET /auth/identities
+type IdentityListResponse struct {
+	Identities []IdentityItem `json:"identities"`
+}
+
+type IdentityItem struct {
+	Provider   string     `json:"provider"`
+	LinkedAt   time.Time  `json:"linkedAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// POST /auth/identities
+type LinkIdentityRequest struct {
+	Provider          string  `json:"provider" validate:"required,oneof=GOOGLE KAKAO APPLE"`
+	IDToken           string  `json:"idToken" validate:"required"`
+	Nonce             string  `json:"nonce"` // APPLE 필수: identity token 요청에 사용한 원본 nonce
+	AppleRefreshToken *string `json:"appleRefreshToken"`
 }
 
 // POST /auth/nickname
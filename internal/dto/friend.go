@@ -3,17 +3,59 @@ package dto
 import "time"
 
 // GET /friends
+type FriendListQuery struct {
+	Sort   string // FRIENDSHIP(기본), IN_VOID, RECENT, NICKNAME
+	Query  string // 닉네임·태그 검색어
+	Cursor string
+	Limit  int
+}
+
 type FriendListResponse struct {
-	Friends []FriendItem `json:"friends"`
+	Friends    []FriendItem `json:"friends"`
+	TotalCount int          `json:"totalCount"` // 검색어에 맞는 전체 친구 수
+	NextCursor *string      `json:"nextCursor"` // 마지막 페이지면 null
 }
 
 type FriendItem struct {
-	UserID          string     `json:"userId"`
-	Nickname        string     `json:"nickname"`
-	Tag             string     `json:"tag"`
-	IsInVoid        bool       `json:"isInVoid"`
-	LastVoidEndedAt *time.Time `json:"lastVoidEndedAt"`
-	CreatedAt       time.Time  `json:"createdAt"`
+	UserID           string      `json:"userId"`
+	Nickname         string      `json:"nickname"`
+	PreviousNickname *string     `json:"previousNickname"` // 최근 닉네임을 변경한 경우 이전 닉네임
+	Tag              string      `json:"tag"`
+	Avatar           *AvatarURLs `json:"avatar"` // 아바타가 없으면 null
+	IsInVoid         bool        `json:"isInVoid"`
+	LastVoidEndedAt  *time.Time  `json:"lastVoidEndedAt"` // 공백 상태를 공개하지 않는 친구면 isInVoid 는 false, lastVoidEndedAt 은 null
+	CircleIDs        []string    `json:"circleIds"`       // 친구가 속한 내 서클
+	CreatedAt        time.Time   `json:"createdAt"`
+}
+
+// GET /friends/suggestions
+type FriendSuggestionListResponse struct {
+	Suggestions []FriendSuggestionItem `json:"suggestions"`
+}
+
+type FriendSuggestionItem struct {
+	User            UserSearchItem `json:"user"`
+	MutualCount     int            `json:"mutualCount"`
+	MutualNicknames []string       `json:"mutualNicknames"` // 함께 아는 친구 중 최대 3명
+}
+
+// GET /friends/feed
+type FeedResponse struct {
+	Items      []FeedItem `json:"items"`
+	NextCursor *string    `json:"nextCursor"` // 마지막 페이지면 null
+}
+
+type FeedItem struct {
+	ID          string         `json:"id"`
+	Type        string         `json:"type"` // SESSION_ENDED, STREAK_MILESTONE, PERSONAL_RECORD
+	User        UserSearchItem `json:"user"`
+	SessionID   string         `json:"sessionId"` // 반응을 남길 세션
+	TargetDay   string         `json:"targetDay"`
+	DurationSec *int64         `json:"durationSec"` // SESSION_ENDED, PERSONAL_RECORD
+	Activities  []string       `json:"activities"`  // 활동을 공개한 친구의 SESSION_ENDED 만, 아니면 null
+	StreakDays  *int           `json:"streakDays"`  // STREAK_MILESTONE
+	MyReaction  *string        `json:"myReaction"`  // SESSION_ENDED 에 내가 남긴 반응, 없으면 null
+	CreatedAt   time.Time      `json:"createdAt"`
 }
 
 // GET /friends/requests?type=received
@@ -24,6 +66,7 @@ type ReceivedRequestsResponse struct {
 type ReceivedRequestItem struct {
 	RequestID string         `json:"requestId"`
 	Sender    UserSearchItem `json:"sender"`
+	Status    string         `json:"status"`
 	CreatedAt time.Time      `json:"createdAt"`
 }
 
@@ -47,3 +90,61 @@ type SendFriendRequestRequest struct {
 type SendFriendRequestResponse struct {
 	RequestID string `json:"requestId"`
 }
+
+// GET /friends/nudges
+type NudgeListResponse struct {
+	TargetDay string      `json:"targetDay"`
+	Nudges    []NudgeItem `json:"nudges"` // 마지막으로 찌른 시각 최신순
+}
+
+type NudgeItem struct {
+	Sender       UserSearchItem `json:"sender"`
+	Count        int            `json:"count"`
+	LastNudgedAt time.Time      `json:"lastNudgedAt"`
+}
+
+// POST /friends/invites
+type CreateFriendInviteRequest struct {
+	ExpiresInHours int `json:"expiresInHours" validate:"omitempty,min=1,max=720"` // 생략하면 72시간
+	MaxUses        int `json:"maxUses" validate:"omitempty,min=1,max=100"`        // 생략하면 10회
+}
+
+type FriendInviteResponse struct {
+	Code      string    `json:"code"`
+	URL       *string   `json:"url"` // 초대 링크 주소가 설정되지 않은 경우 null
+	MaxUses   int       `json:"maxUses"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// POST /friends/invites/:code/redeem
+type RedeemFriendInviteResponse struct {
+	Friend UserSearchItem `json:"friend"`
+}
+
+// GET /friends/circles
+type CircleListResponse struct {
+	Circles []CircleItem `json:"circles"`
+}
+
+type CircleItem struct {
+	ID             string           `json:"id"`
+	Name           string           `json:"name"`
+	IsCloseFriends bool             `json:"isCloseFriends"`
+	Members        []UserSearchItem `json:"members"`
+	CreatedAt      time.Time        `json:"createdAt"`
+}
+
+// POST /friends/circles
+type CreateCircleRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=20"`
+}
+
+// PATCH /friends/circles/:circle_id
+type UpdateCircleRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=20"`
+}
+
+// PUT /friends/circles/:circle_id/members
+type SetCircleMembersRequest struct {
+	UserIDs []string `json:"userIds"` // 친구만 넣을 수 있음. 빈 배열이면 모두 제외
+}
@@ -15,6 +15,7 @@ type Response[T any] struct {
 type ErrorResponse struct {
 	Success bool             `json:"success"`
 	Code    domain.ErrorCode `json:"code"`
+	Data    any              `json:"data,omitempty"`
 }
 
 func Success[T any](c echo.Context, statusCode int, data T) error {
@@ -36,3 +37,11 @@ func Fail(c echo.Context, statusCode int, code domain.ErrorCode) error {
 		Code:    code,
 	})
 }
+
+func FailWithData(c echo.Context, statusCode int, code domain.ErrorCode, data any) error {
+	return c.JSON(statusCode, ErrorResponse{
+		Success: false,
+		Code:    code,
+		Data:    data,
+	})
+}
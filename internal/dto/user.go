@@ -7,9 +7,12 @@ type UserMeResponse struct {
 	ID                   string               `json:"id"`
 	Tag                  string               `json:"tag"`
 	Nickname             string               `json:"nickname"`
+	Avatar               *AvatarURLs          `json:"avatar"` // 아바타가 없으면 null
 	IsInVoid             bool                 `json:"isInVoid"`
 	CurrentVoidStartedAt *time.Time           `json:"currentVoidStartedAt"`
 	NotificationSettings NotificationSettings `json:"notificationSettings"`
+	PrivacySettings      PrivacySettings      `json:"privacySettings"`
+	NicknameChangeableAt *time.Time           `json:"nicknameChangeableAt"` // 변경 대기 중이 아니면 null
 }
 
 type NotificationSettings struct {
@@ -34,6 +37,39 @@ type UpdateSettingsResponse struct {
 	FriendNudge   bool `json:"friendNudge"`
 }
 
+// PATCH /users/me/privacy
+// 서클 목록은 보낸 필드만 바꾸며, 빈 배열이면 모든 친구에게 공개
+type UpdatePrivacyRequest struct {
+	StatsVisibility *string   `json:"statsVisibility"` // EVERYONE, FRIENDS, NOBODY
+	StatsCircles    *[]string `json:"statsCircles"`    // statsVisibility 가 FRIENDS 일 때 통계를 볼 수 있는 서클
+	PresenceCircles *[]string `json:"presenceCircles"` // 공백 중 여부를 볼 수 있는 서클
+	SessionCircles  *[]string `json:"sessionCircles"`  // 친구 피드에서 공백 기록을 볼 수 있는 서클
+	ShareActivities *bool     `json:"shareActivities"` // 친구 피드의 공백 기록에 활동을 함께 공개
+}
+
+type PrivacySettings struct {
+	StatsVisibility string   `json:"statsVisibility"`
+	StatsCircles    []string `json:"statsCircles"`
+	PresenceCircles []string `json:"presenceCircles"`
+	SessionCircles  []string `json:"sessionCircles"`
+	ShareActivities bool     `json:"shareActivities"`
+}
+
+// GET /users/:user_id
+type UserProfileResponse struct {
+	UserID           string        `json:"userId"`
+	Nickname         string        `json:"nickname"`
+	Tag              string        `json:"tag"`
+	Avatar           *AvatarURLs   `json:"avatar"`           // 아바타가 없으면 null
+	FriendshipStatus string        `json:"friendshipStatus"` // SELF, FRIEND, PENDING_SENT, PENDING_RECEIVED, BLOCKED, NONE
+	Stats            *ProfileStats `json:"stats"`            // 공개하지 않는 유저면 null
+}
+
+type ProfileStats struct {
+	StreakDays           int   `json:"streakDays"`           // 오늘(또는 어제)까지 연속으로 공백한 일수
+	WeekTotalDurationSec int64 `json:"weekTotalDurationSec"` // 이번 주(월요일 시작) 총 공백 시간
+}
+
 // GET /users/blocks
 type BlockListResponse struct {
 	Blocks []BlockItem `json:"blocks"`
@@ -48,16 +84,46 @@ type ChangeNicknameResponse struct {
 	Nickname string `json:"nickname"`
 }
 
+// NICKNAME_CHANGE_COOLDOWN 에러의 data
+type NicknameCooldownData struct {
+	NextChangeAt time.Time `json:"nextChangeAt"`
+}
+
+// PATCH /users/me/tag
+type ChangeTagRequest struct {
+	Tag string `json:"tag" validate:"required,min=4,max=12"`
+}
+
+type ChangeTagResponse struct {
+	Tag string `json:"tag"`
+}
+
+// TAG_CHANGE_COOLDOWN 에러의 data (한 번만 변경 가능한 설정이면 nextChangeAt 은 null)
+type TagCooldownData struct {
+	NextChangeAt *time.Time `json:"nextChangeAt"`
+}
+
+// PUT /users/me/avatar
+type AvatarResponse struct {
+	Avatar AvatarURLs `json:"avatar"`
+}
+
+type AvatarURLs struct {
+	Small string `json:"small"` // 128x128
+	Large string `json:"large"` // 512x512
+}
+
 // GET /users/search
 type UserSearchResponse struct {
 	Users []UserSearchItem `json:"users"`
 }
 
 type UserSearchItem struct {
-	UserID    string `json:"userId"`
-	Nickname  string `json:"nickname"`
-	Tag       string `json:"tag"`
-	IsBlocked bool   `json:"isBlocked"`
+	UserID    string      `json:"userId"`
+	Nickname  string      `json:"nickname"`
+	Tag       string      `json:"tag"`
+	Avatar    *AvatarURLs `json:"avatar"` // 아바타가 없으면 null
+	IsBlocked bool        `json:"isBlocked"`
 }
 
 type BlockItem struct {
@@ -66,3 +132,15 @@ type BlockItem struct {
 	Tag       string    `json:"tag"`
 	BlockedAt time.Time `json:"blockedAt"`
 }
+
+// POST /users/me/export, GET /users/me/export
+type DataExportResponse struct {
+	ExportID            string     `json:"exportId"`
+	Status              string     `json:"status"` // PENDING, READY, FAILED
+	CreatedAt           time.Time  `json:"createdAt"`
+	CompletedAt         *time.Time `json:"completedAt"`
+	SizeBytes           int64      `json:"sizeBytes"`
+	ExpiresAt           *time.Time `json:"expiresAt"`           // 파일 보관 기한
+	DownloadURL         *string    `json:"downloadUrl"`         // READY 일 때만, 로그인 없이 받을 수 있는 서명된 링크
+	DownloadURLExpireAt *time.Time `json:"downloadUrlExpireAt"` // 만료되면 다시 조회해 새 링크를 받음
+}
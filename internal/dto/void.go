@@ -7,6 +7,7 @@ type VoidStartResponse struct {
 	SessionID string    `json:"sessionId"`
 	StartedAt time.Time `json:"startedAt"`
 	TargetDay string    `json:"targetDay"`
+	RoomID    *string   `json:"roomId"` // 들어가 있는 공백 방, 없으면 null
 }
 
 // POST /void/end
@@ -21,6 +22,7 @@ type VoidEndResponse struct {
 	DurationSec int64     `json:"durationSec"`
 	TargetDay   string    `json:"targetDay"`
 	Activities  []string  `json:"activities"`
+	RoomID      *string   `json:"roomId"` // 세션이 연결된 공백 방, 없으면 null
 }
 
 // POST /void/test - 테스트 공백 데이터 생성
@@ -38,9 +40,49 @@ type VoidHistoryResponse struct {
 }
 
 type VoidSession struct {
-	SessionID   string    `json:"sessionId"`
-	StartedAt   time.Time `json:"startedAt"`
-	EndedAt     time.Time `json:"endedAt"`
-	DurationSec int64     `json:"durationSec"`
-	Activities  []string  `json:"activities"`
+	SessionID      string         `json:"sessionId"`
+	StartedAt      time.Time      `json:"startedAt"`
+	EndedAt        time.Time      `json:"endedAt"`
+	DurationSec    int64          `json:"durationSec"`
+	Activities     []string       `json:"activities"`
+	ReactionCounts map[string]int `json:"reactionCounts"` // 이모지별 친구 반응 수
+	RoomID         *string        `json:"roomId"`         // 함께 공백한 방, 없으면 null
+}
+
+// PUT /void/sessions/:session_id/reaction
+type SessionReactionRequest struct {
+	Emoji string `json:"emoji" example:"CLAP"` // CLAP, FIRE, HEART, MOON, COFFEE
+}
+
+// POST /void/rooms
+type CreateVoidRoomRequest struct {
+	UserIDs []string `json:"userIds"` // 초대할 친구
+}
+
+// POST /void/rooms/:room_id/invite
+type InviteVoidRoomRequest struct {
+	UserIDs []string `json:"userIds"`
+}
+
+// GET /void/rooms/current, GET /void/rooms/:room_id
+type VoidRoomResponse struct {
+	RoomID           string           `json:"roomId"`
+	OwnerID          string           `json:"ownerId"`
+	Status           string           `json:"status"` // OPEN, CLOSED
+	Members          []VoidRoomMember `json:"members"`
+	MaxMembers       int              `json:"maxMembers"`
+	TotalDurationSec int64            `json:"totalDurationSec"` // 방에 연결된 끝난 공백의 합. 닫힌 방은 닫힐 때의 합계
+	SessionCount     int              `json:"sessionCount"`
+	CreatedAt        time.Time        `json:"createdAt"`
+	ClosedAt         *time.Time       `json:"closedAt"`
+}
+
+type VoidRoomMember struct {
+	User             UserSearchItem `json:"user"`
+	IsMember         bool           `json:"isMember"`      // 지금 방에 있는지. false 면 나간 유저
+	IsInVoid         bool           `json:"isInVoid"`      // 열린 방의 멤버만, 보는 사람도 멤버일 때만
+	VoidStartedAt    *time.Time     `json:"voidStartedAt"` // 공백 중일 때만
+	ElapsedSec       *int64         `json:"elapsedSec"`    // 공백 중일 때만
+	TotalDurationSec int64          `json:"totalDurationSec"`
+	SessionCount     int            `json:"sessionCount"`
 }
@@ -0,0 +1,287 @@
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 설정 파일이 없을 때 사용하는 기본 예약어
+var defaultReserved = []string{
+	"admin", "administrator", "system", "official",
+	"관리자", "운영자", "운영진", "공식",
+	"당밤공", "dangbamgong",
+}
+
+type nicknameWordList struct {
+	Banned   []string `json:"banned"`
+	Reserved []string `json:"reserved"`
+}
+
+type NicknameFilter struct {
+	mu       sync.RWMutex
+	path     string
+	modTime  time.Time
+	banned   []string
+	reserved []string
+}
+
+// NICKNAME_FILTER_PATH 의 JSON 파일({"banned": [...], "reserved": [...]})을 읽어 필터를 생성한다.
+// 파일이 설정되지 않았거나 읽을 수 없으면 기본 예약어만 사용한다.
+func NewNicknameFilter() *NicknameFilter {
+	f := &NicknameFilter{
+		path:     os.Getenv("NICKNAME_FILTER_PATH"),
+		reserved: normalizeAll(defaultReserved),
+	}
+
+	if f.path == "" {
+		log.Println("[FILTER] nickname filter path not configured, using default reserved names")
+		return f
+	}
+
+	if err := f.Reload(); err != nil {
+		log.Printf("[FILTER] failed to load nickname filter: %v, using default reserved names\n", err)
+	}
+	return f
+}
+
+// Reload 는 단어 목록 파일을 다시 읽어 교체한다. 실패하면 기존 목록을 유지한다.
+func (f *NicknameFilter) Reload() error {
+	if f.path == "" {
+		return nil
+	}
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+
+	var list nicknameWordList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	banned := normalizeAll(list.Banned)
+	reserved := normalizeAll(append(list.Reserved, defaultReserved...))
+
+	f.mu.Lock()
+	f.banned = banned
+	f.reserved = reserved
+	f.modTime = info.ModTime()
+	f.mu.Unlock()
+
+	log.Printf("[FILTER] loaded %d banned words, %d reserved names\n", len(banned), len(reserved))
+	return nil
+}
+
+// Watch 는 interval 마다 파일 변경 시각을 확인해 바뀌었으면 다시 읽는다.
+func (f *NicknameFilter) Watch(ctx context.Context, interval time.Duration) {
+	if f.path == "" || interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				info, err := os.Stat(f.path)
+				if err != nil {
+					log.Printf("[FILTER] failed to stat nickname filter: %v\n", err)
+					continue
+				}
+
+				f.mu.RLock()
+				changed := !info.ModTime().Equal(f.modTime)
+				f.mu.RUnlock()
+
+				if !changed {
+					continue
+				}
+				if err := f.Reload(); err != nil {
+					log.Printf("[FILTER] failed to reload nickname filter: %v\n", err)
+				}
+			}
+		}
+	}()
+}
+
+// IsAllowed 는 닉네임에 금칙어나 예약어가 없는지 확인한다.
+// 금칙어는 정규화한 문자열 어디에 들어 있어도 막고, 예약어는 단어나 음절 경계에 맞을 때만 막는다
+// (badminton 의 admin, 공시기 의 공식 은 허용).
+func (f *NicknameFilter) IsAllowed(nickname string) bool {
+	normalized := Normalize(nickname)
+	if normalized == "" {
+		return true
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, w := range f.banned {
+		if strings.Contains(normalized, w) {
+			return false
+		}
+	}
+
+	segs := segments(nickname)
+	for _, w := range f.reserved {
+		if matchesSegments(segs, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// segments 는 닉네임을 경계 단위로 나눠 각각 정규화한다.
+// 한글은 음절(또는 낱자모) 하나가 한 단위이고, 그 밖의 문자는 기호·공백, 숫자와 문자 사이,
+// 소문자 뒤 대문자(camelCase)에서 나뉜다.
+func segments(s string) []string {
+	s = norm.NFKC.String(s)
+
+	var result []string
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			result = append(result, Normalize(b.String()))
+			b.Reset()
+		}
+	}
+
+	var prev rune
+	for _, r := range s {
+		switch {
+		case isHangul(r):
+			flush()
+			result = append(result, Normalize(string(r)))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if b.Len() > 0 && (unicode.IsDigit(prev) != unicode.IsDigit(r) || unicode.IsLower(prev) && unicode.IsUpper(r)) {
+				flush()
+			}
+			b.WriteRune(r)
+		default:
+			flush()
+		}
+		prev = r
+	}
+	flush()
+	return result
+}
+
+// matchesSegments 는 이어진 단위 몇 개를 붙였을 때 word 와 정확히 같아지는지 확인한다.
+// a.d.m.i.n 처럼 기호로 쪼갠 예약어는 잡고, 단위 중간에서 시작하거나 끝나는 일치는 무시한다.
+func matchesSegments(segs []string, word string) bool {
+	for i := range segs {
+		joined := ""
+		for _, seg := range segs[i:] {
+			joined += seg
+			if joined == word {
+				return true
+			}
+			if !strings.HasPrefix(word, joined) {
+				break
+			}
+		}
+	}
+	return false
+}
+
+func isHangul(r rune) bool {
+	return (r >= hangulBase && r <= hangulLast) ||
+		(r >= 0x1100 && r <= 0x11FF) ||
+		(r >= 0x3131 && r <= 0x3163)
+}
+
+func normalizeAll(words []string) []string {
+	result := make([]string, 0, len(words))
+	for _, w := range words {
+		if n := Normalize(w); n != "" {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// Normalize 는 비교용 문자열을 만든다.
+//   - NFKC 로 전각 문자와 호환 문자를 일반 문자로 변환
+//   - 소문자 변환
+//   - 한글 음절과 자모를 모두 기본 자모 단위로 분해 (ㄳ → ㄱㅅ, ㅘ → ㅗㅏ)
+//   - 문자와 숫자 이외의 공백, 기호, 제어 문자는 제거
+func Normalize(s string) string {
+	s = norm.NFKC.String(s)
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= hangulBase && r <= hangulLast:
+			idx := r - hangulBase
+			b.WriteString(choseong[idx/(jungseongCount*jongseongCount)])
+			b.WriteString(jungseong[(idx%(jungseongCount*jongseongCount))/jongseongCount])
+			b.WriteString(jongseong[idx%jongseongCount])
+		case r >= 0x1100 && r <= 0x1112:
+			b.WriteString(choseong[r-0x1100])
+		case r >= 0x1161 && r <= 0x1175:
+			b.WriteString(jungseong[r-0x1161])
+		case r >= 0x11A8 && r <= 0x11C2:
+			b.WriteString(jongseong[r-0x11A8+1])
+		case r >= 0x3131 && r <= 0x3163:
+			if d, ok := compoundJamo[r]; ok {
+				b.WriteString(d)
+			} else {
+				b.WriteRune(r)
+			}
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+const (
+	hangulBase     = 0xAC00
+	hangulLast     = 0xD7A3
+	jungseongCount = 21
+	jongseongCount = 28
+)
+
+var choseong = []string{
+	"ㄱ", "ㄱㄱ", "ㄴ", "ㄷ", "ㄷㄷ", "ㄹ", "ㅁ", "ㅂ", "ㅂㅂ", "ㅅ",
+	"ㅅㅅ", "ㅇ", "ㅈ", "ㅈㅈ", "ㅊ", "ㅋ", "ㅌ", "ㅍ", "ㅎ",
+}
+
+var jungseong = []string{
+	"ㅏ", "ㅐ", "ㅑ", "ㅒ", "ㅓ", "ㅔ", "ㅕ", "ㅖ", "ㅗ", "ㅗㅏ",
+	"ㅗㅐ", "ㅗㅣ", "ㅛ", "ㅜ", "ㅜㅓ", "ㅜㅔ", "ㅜㅣ", "ㅠ", "ㅡ", "ㅡㅣ",
+	"ㅣ",
+}
+
+var jongseong = []string{
+	"", "ㄱ", "ㄱㄱ", "ㄱㅅ", "ㄴ", "ㄴㅈ", "ㄴㅎ", "ㄷ", "ㄹ", "ㄹㄱ",
+	"ㄹㅁ", "ㄹㅂ", "ㄹㅅ", "ㄹㅌ", "ㄹㅍ", "ㄹㅎ", "ㅁ", "ㅂ", "ㅂㅅ", "ㅅ",
+	"ㅅㅅ", "ㅇ", "ㅈ", "ㅊ", "ㅋ", "ㅌ", "ㅍ", "ㅎ",
+}
+
+// 호환 자모 중 겹자모의 분해 (NFKC 이후에도 남는 경우 대비)
+var compoundJamo = map[rune]string{
+	'ㄲ': "ㄱㄱ", 'ㄳ': "ㄱㅅ", 'ㄵ': "ㄴㅈ", 'ㄶ': "ㄴㅎ", 'ㄸ': "ㄷㄷ",
+	'ㄺ': "ㄹㄱ", 'ㄻ': "ㄹㅁ", 'ㄼ': "ㄹㅂ", 'ㄽ': "ㄹㅅ", 'ㄾ': "ㄹㅌ",
+	'ㄿ': "ㄹㅍ", 'ㅀ': "ㄹㅎ", 'ㅃ': "ㅂㅂ", 'ㅄ': "ㅂㅅ", 'ㅆ': "ㅅㅅ",
+	'ㅉ': "ㅈㅈ", 'ㅘ': "ㅗㅏ", 'ㅙ': "ㅗㅐ", 'ㅚ': "ㅗㅣ", 'ㅝ': "ㅜㅓ",
+	'ㅞ': "ㅜㅔ", 'ㅟ': "ㅜㅣ", 'ㅢ': "ㅡㅣ",
+}
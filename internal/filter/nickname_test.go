@@ -0,0 +1,84 @@
+package filter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "fullwidth", in: "ＡＤＭＩＮ", want: "admin"},
+		{name: "compatibility digit", in: "①２", want: "12"},
+		{name: "symbols and spaces", in: "a d-m_i!n", want: "admin"},
+		{name: "syllable", in: "공식", want: "ㄱㅗㅇㅅㅣㄱ"},
+		{name: "compound vowel and final", in: "괆", want: "ㄱㅗㅏㄹㅁ"},
+		{name: "compatibility jamo", in: "ㄳㅘ", want: "ㄱㅅㅗㅏ"},
+		{name: "conjoining jamo", in: "\u1100\u1169\u11bc", want: "ㄱㅗㅇ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNicknameFilterIsAllowed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nickname.json")
+	if err := os.WriteFile(path, []byte(`{"banned": ["바보", "jerk"], "reserved": ["운영팀"]}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	t.Setenv("NICKNAME_FILTER_PATH", path)
+	f := NewNicknameFilter()
+
+	tests := []struct {
+		nickname string
+		want     bool
+	}{
+		// 예약어는 경계에 맞을 때만 막음
+		{nickname: "admin", want: false},
+		{nickname: "Admin", want: false},
+		{nickname: "ＡＤＭＩＮ", want: false},
+		{nickname: "admin_kim", want: false},
+		{nickname: "kimAdmin", want: false},
+		{nickname: "admin123", want: false},
+		{nickname: "a.d.m.i.n", want: false},
+		{nickname: "SystemBot", want: false},
+		{nickname: "공식", want: false},
+		{nickname: "당밤공운영자", want: false},
+		{nickname: "ㄱㅗㅇㅅㅣㄱ", want: false},
+		{nickname: "운영팀장", want: false},
+		{nickname: "badminton", want: true},
+		{nickname: "ecosystem", want: true},
+		{nickname: "unofficially", want: true},
+		{nickname: "공시기", want: true},
+		{nickname: "성공식당", want: false},
+		{nickname: "밤공기", want: true},
+
+		// 금칙어는 어디에 있어도 막음
+		{nickname: "바보", want: false},
+		{nickname: "바 보", want: false},
+		{nickname: "바봏", want: false},
+		{nickname: "ＪＥＲＫ", want: false},
+		{nickname: "jerkface", want: false},
+		{nickname: "바다보기", want: true},
+
+		{nickname: "당근", want: true},
+		{nickname: "", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.nickname, func(t *testing.T) {
+			if got := f.IsAllowed(tt.nickname); got != tt.want {
+				t.Errorf("IsAllowed(%q) = %v, want %v", tt.nickname, got, tt.want)
+			}
+		})
+	}
+}
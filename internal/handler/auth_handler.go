@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"dangbamgong-backend/internal/auth"
 	"dangbamgong-backend/internal/dto"
 	"dangbamgong-backend/internal/middleware"
 	"dangbamgong-backend/internal/service"
@@ -11,16 +12,17 @@ import (
 )
 
 type AuthHandler struct {
-	service service.AuthService
+	service  service.AuthService
+	sessions service.SessionService
 }
 
-func NewAuthHandler(s service.AuthService) *AuthHandler {
-	return &AuthHandler{service: s}
+func NewAuthHandler(s service.AuthService, ss service.SessionService) *AuthHandler {
+	return &AuthHandler{service: s, sessions: ss}
 }
 
 // Login godoc
 // @Summary      소셜 로그인
-// @Description  Google/Kakao/Apple 소셜 로그인을 처리합니다. 신규 유저인 경우 자동 가입됩니다.
+// @Description  Google/Kakao/Apple 소셜 로그인을 처리합니다. 신규 유저인 경우 자동 가입되고, 탈퇴 유예 중인 계정은 복구됩니다.
 // @Tags         Auth
 // @Accept       json
 // @Produce      json
@@ -28,6 +30,8 @@ func NewAuthHandler(s service.AuthService) *AuthHandler {
 // @Success      200   {object}  dto.Response[dto.LoginResponse]
 // @Failure      400   {object}  dto.ErrorResponse
 // @Failure      401   {object}  dto.ErrorResponse
+// @Failure      403   {object}  dto.ErrorResponse  "ACCOUNT_SUSPENDED"
+// @Failure      429   {object}  dto.ErrorResponse  "RATE_LIMITED (Retry-After)"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c echo.Context) error {
 	var req dto.LoginRequest
@@ -38,6 +42,9 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return err
 	}
 
+	req.Device.UserAgent = c.Request().UserAgent()
+	req.Device.IP = c.RealIP()
+
 	resp, err := h.service.Login(c.Request().Context(), req)
 	if err != nil {
 		return err
@@ -46,6 +53,35 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	return dto.Success(c, http.StatusOK, resp)
 }
 
+// Refresh godoc
+// @Summary      토큰 갱신
+// @Description  refresh token 으로 새 액세스 토큰과 refresh token 을 발급합니다. 사용한 refresh token 은 폐기되며, 이미 사용한 토큰을 다시 보내면 해당 로그인의 모든 토큰이 폐기됩니다.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body  body      dto.RefreshRequest  true  "refresh token"
+// @Success      200   {object}  dto.Response[dto.RefreshResponse]
+// @Failure      401   {object}  dto.ErrorResponse  "INVALID_TOKEN / REFRESH_TOKEN_REUSED"
+// @Failure      403   {object}  dto.ErrorResponse  "ACCOUNT_SUSPENDED"
+// @Failure      429   {object}  dto.ErrorResponse  "RATE_LIMITED (Retry-After)"
+// @Router       /auth/refresh [post]
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var req dto.RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.Refresh(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
 // TestLogin godoc
 // @Summary      테스트 로그인
 // @Description  개발용 테스트 로그인. socialId로 직접 로그인합니다.
@@ -54,6 +90,7 @@ func (h *AuthHandler) Login(c echo.Context) error {
 // @Produce      json
 // @Param        body  body      dto.TestLoginRequest  true  "테스트 로그인 정보"
 // @Success      200   {object}  dto.Response[dto.LoginResponse]
+// @Failure      429   {object}  dto.ErrorResponse  "RATE_LIMITED (Retry-After)"
 // @Router       /auth/login/test [post]
 func (h *AuthHandler) TestLogin(c echo.Context) error {
 	var req dto.TestLoginRequest
@@ -64,6 +101,9 @@ func (h *AuthHandler) TestLogin(c echo.Context) error {
 		return err
 	}
 
+	req.Device.UserAgent = c.Request().UserAgent()
+	req.Device.IP = c.RealIP()
+
 	resp, err := h.service.TestLogin(c.Request().Context(), req)
 	if err != nil {
 		return err
@@ -81,7 +121,7 @@ func (h *AuthHandler) TestLogin(c echo.Context) error {
 // @Security     BearerAuth
 // @Param        body  body      dto.SetNicknameRequest  true  "닉네임 (3-15자)"
 // @Success      200   {object}  dto.Response[dto.SetNicknameResponse]
-// @Failure      400   {object}  dto.ErrorResponse  "INVALID_NICKNAME"
+// @Failure      400   {object}  dto.ErrorResponse  "INVALID_NICKNAME / NICKNAME_NOT_ALLOWED"
 // @Failure      409   {object}  dto.ErrorResponse  "NICKNAME_ALREADY_SET"
 // @Router       /auth/nickname [post]
 func (h *AuthHandler) SetNickname(c echo.Context) error {
@@ -102,18 +142,161 @@ func (h *AuthHandler) SetNickname(c echo.Context) error {
 
 // Withdraw godoc
 // @Summary      회원 탈퇴
-// @Description  계정을 삭제합니다. (미완성)
+// @Description  탈퇴를 요청합니다. 계정은 즉시 다른 유저에게 보이지 않고 모든 기기에서 로그아웃되며, Apple 계정은 저장된 refresh token 을 바로 revoke 합니다. deleteAt 전에 다시 로그인하면 복구되고, 이후에는 모든 데이터가 삭제됩니다.
 // @Tags         Auth
 // @Produce      json
 // @Security     BearerAuth
-// @Success      200   {object}  dto.Response[any]
+// @Success      200   {object}  dto.Response[dto.WithdrawResponse]
 // @Router       /auth/withdraw [delete]
 func (h *AuthHandler) Withdraw(c echo.Context) error {
 	userID := c.Get(middleware.ContextKeyUserID).(string)
 
-	if err := h.service.Withdraw(c.Request().Context(), userID); err != nil {
+	resp, err := h.service.Withdraw(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// GetSessions godoc
+// @Summary      로그인 기기 목록
+// @Description  현재 로그인되어 있는 세션(기기) 목록을 최근 사용 순으로 반환합니다
+// @Tags         Auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[dto.SessionListResponse]
+// @Router       /auth/sessions [get]
+func (h *AuthHandler) GetSessions(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	sessionID := c.Get(middleware.ContextKeySessionID).(string)
+
+	resp, err := h.sessions.GetSessions(c.Request().Context(), userID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// Logout godoc
+// @Summary      로그아웃
+// @Description  현재 세션을 종료합니다. 이 세션의 액세스 토큰과 refresh token 은 즉시 사용할 수 없습니다.
+// @Tags         Auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[any]
+// @Router       /auth/logout [post]
+func (h *AuthHandler) Logout(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	sessionID := c.Get(middleware.ContextKeySessionID).(string)
+
+	if err := h.sessions.Logout(c.Request().Context(), userID, sessionID); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
+// LogoutOthers godoc
+// @Summary      다른 기기 모두 로그아웃
+// @Description  현재 세션을 제외한 모든 세션을 종료합니다
+// @Tags         Auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[any]
+// @Router       /auth/logout/others [post]
+func (h *AuthHandler) LogoutOthers(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	sessionID := c.Get(middleware.ContextKeySessionID).(string)
+
+	if err := h.sessions.LogoutOthers(c.Request().Context(), userID, sessionID); err != nil {
 		return err
 	}
 
 	return dto.SuccessEmpty(c, http.StatusOK)
 }
+
+// GetIdentities godoc
+// @Summary      연결된 소셜 계정 목록
+// @Description  현재 계정에 연결된 소셜 로그인 제공자 목록을 연결한 순서대로 반환합니다
+// @Tags         Auth
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[dto.IdentityListResponse]
+// @Router       /auth/identities [get]
+func (h *AuthHandler) GetIdentities(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	resp, err := h.service.GetIdentities(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// LinkIdentity godoc
+// @Summary      소셜 계정 연결
+// @Description  다른 소셜 로그인 제공자를 현재 계정에 연결합니다. 연결 후에는 어느 제공자로 로그인해도 같은 계정으로 로그인됩니다. 제공자별로 하나의 계정만 연결할 수 있습니다.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.LinkIdentityRequest  true  "연결할 소셜 계정 정보"
+// @Success      200   {object}  dto.Response[dto.IdentityListResponse]
+// @Failure      400   {object}  dto.ErrorResponse
+// @Failure      401   {object}  dto.ErrorResponse  "INVALID_TOKEN"
+// @Failure      409   {object}  dto.ErrorResponse  "IDENTITY_ALREADY_LINKED / PROVIDER_ALREADY_LINKED"
+// @Router       /auth/identities [post]
+func (h *AuthHandler) LinkIdentity(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	var req dto.LinkIdentityRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.LinkIdentity(c.Request().Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// UnlinkIdentity godoc
+// @Summary      소셜 계정 연결 해제
+// @Description  소셜 로그인 제공자 연결을 해제합니다. 마지막으로 남은 계정은 해제할 수 없습니다.
+// @Tags         Auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        provider  path      string  true  "제공자 (GOOGLE, KAKAO, APPLE)"
+// @Success      200       {object}  dto.Response[any]
+// @Failure      404       {object}  dto.ErrorResponse  "IDENTITY_NOT_FOUND"
+// @Failure      409       {object}  dto.ErrorResponse  "LAST_IDENTITY"
+// @Router       /auth/identities/{provider} [delete]
+func (h *AuthHandler) UnlinkIdentity(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	if err := h.service.UnlinkIdentity(c.Request().Context(), userID, c.Param("provider")); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
+// JWKS godoc
+// @Summary      액세스 토큰 검증 키
+// @Description  액세스 토큰 서명을 검증할 수 있는 공개 키 목록을 JWKS 형식으로 반환합니다. 키 교체 중에는 이전 키도 함께 포함됩니다. 공통 응답 형식으로 감싸지 않습니다.
+// @Tags         Auth
+// @Produce      json
+// @Success      200  {object}  auth.JWKSet
+// @Router       /.well-known/jwks.json [get]
+func (h *AuthHandler) JWKS(c echo.Context) error {
+	c.Response().Header().Set("Cache-Control", "public, max-age=3600")
+	return c.JSON(http.StatusOK, auth.PublicJWKS())
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/middleware"
+	"dangbamgong-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CircleHandler struct {
+	service service.CircleService
+}
+
+func NewCircleHandler(s service.CircleService) *CircleHandler {
+	return &CircleHandler{service: s}
+}
+
+// List godoc
+// @Summary      서클 목록 조회
+// @Description  내가 만든 서클과 멤버를 반환합니다. 친한 친구 서클은 항상 맨 앞에 있습니다.
+// @Tags         Circles
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[dto.CircleListResponse]
+// @Router       /friends/circles [get]
+func (h *CircleHandler) List(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	resp, err := h.service.List(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// Create godoc
+// @Summary      서클 생성
+// @Description  빈 서클을 만듭니다. 이름은 1-20자입니다.
+// @Tags         Circles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.CreateCircleRequest  true  "서클 이름"
+// @Success      201   {object}  dto.Response[dto.CircleItem]
+// @Failure      400   {object}  dto.ErrorResponse  "INVALID_CIRCLE_NAME / TOO_MANY_CIRCLES"
+// @Router       /friends/circles [post]
+func (h *CircleHandler) Create(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	var req dto.CreateCircleRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.Create(c.Request().Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusCreated, resp)
+}
+
+// UpdateName godoc
+// @Summary      서클 이름 수정
+// @Description  서클 이름을 수정합니다
+// @Tags         Circles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        circle_id  path  string                   true  "서클 ID"
+// @Param        body       body  dto.UpdateCircleRequest  true  "새 이름"
+// @Success      200  {object}  dto.Response[any]
+// @Failure      400  {object}  dto.ErrorResponse  "INVALID_CIRCLE_NAME"
+// @Failure      404  {object}  dto.ErrorResponse  "CIRCLE_NOT_FOUND"
+// @Router       /friends/circles/{circle_id} [patch]
+func (h *CircleHandler) UpdateName(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	circleID := c.Param("circle_id")
+
+	var req dto.UpdateCircleRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := h.service.UpdateName(c.Request().Context(), userID, circleID, req.Name); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
+// Delete godoc
+// @Summary      서클 삭제
+// @Description  서클을 삭제합니다. 친한 친구 서클과 공개 범위 설정에 쓰이고 있는 서클은 삭제할 수 없습니다.
+// @Tags         Circles
+// @Produce      json
+// @Security     BearerAuth
+// @Param        circle_id  path  string  true  "서클 ID"
+// @Success      200  {object}  dto.Response[any]
+// @Failure      400  {object}  dto.ErrorResponse  "CIRCLE_NOT_DELETABLE"
+// @Failure      404  {object}  dto.ErrorResponse  "CIRCLE_NOT_FOUND"
+// @Failure      409  {object}  dto.ErrorResponse  "CIRCLE_IN_USE"
+// @Router       /friends/circles/{circle_id} [delete]
+func (h *CircleHandler) Delete(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	circleID := c.Param("circle_id")
+
+	if err := h.service.Delete(c.Request().Context(), userID, circleID); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
+// SetMembers godoc
+// @Summary      서클 멤버 변경
+// @Description  서클 멤버를 보낸 목록으로 바꿉니다. 친구만 넣을 수 있으며, 친구를 끊거나 차단하면 서클에서도 빠집니다.
+// @Tags         Circles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        circle_id  path  string                       true  "서클 ID"
+// @Param        body       body  dto.SetCircleMembersRequest  true  "멤버 유저 ID 목록"
+// @Success      200  {object}  dto.Response[dto.CircleItem]
+// @Failure      400  {object}  dto.ErrorResponse  "CIRCLE_MEMBER_NOT_FRIEND"
+// @Failure      404  {object}  dto.ErrorResponse  "CIRCLE_NOT_FOUND"
+// @Router       /friends/circles/{circle_id}/members [put]
+func (h *CircleHandler) SetMembers(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	circleID := c.Param("circle_id")
+
+	var req dto.SetCircleMembersRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.SetMembers(c.Request().Context(), userID, circleID, req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
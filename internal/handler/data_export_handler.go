@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/middleware"
+	"dangbamgong-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DataExportHandler struct {
+	service service.DataExportService
+}
+
+func NewDataExportHandler(s service.DataExportService) *DataExportHandler {
+	return &DataExportHandler{service: s}
+}
+
+// RequestExport godoc
+// @Summary      개인 데이터 내보내기 요청
+// @Description  내 계정 정보, 활동, 밤샘 기록, 친구, 친구 요청, 차단, 알림, 디바이스 토큰, 찌르기 기록, 서클, 숨긴 친구 추천, 피드 활동, 공백 반응, 함께 공백한 방을 JSON 과 CSV 로 묶은 ZIP 파일을 만듭니다. 완료 여부는 GET /users/me/export 로 확인합니다. 진행 중인 작업이 있으면 그 작업을 반환하며, 24시간에 한 번만 요청할 수 있습니다.
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      202  {object}  dto.Response[dto.DataExportResponse]
+// @Failure      429  {object}  dto.ErrorResponse  "EXPORT_RATE_LIMITED (Retry-After, data.retryAfterSec)"
+// @Router       /users/me/export [post]
+func (h *DataExportHandler) RequestExport(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	resp, err := h.service.RequestExport(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusAccepted, resp)
+}
+
+// GetExport godoc
+// @Summary      개인 데이터 내보내기 상태
+// @Description  가장 최근 내보내기 작업의 상태를 반환합니다. READY 이면 로그인 없이 받을 수 있는 서명된 다운로드 링크가 포함되며, 링크가 만료되면 다시 조회해 새 링크를 받습니다.
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[dto.DataExportResponse]
+// @Failure      404  {object}  dto.ErrorResponse  "EXPORT_NOT_FOUND"
+// @Router       /users/me/export [get]
+func (h *DataExportHandler) GetExport(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	resp, err := h.service.GetExport(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// Download godoc
+// @Summary      개인 데이터 다운로드
+// @Description  GET /users/me/export 가 반환한 서명된 링크로 ZIP 파일을 내려받습니다
+// @Tags         Users
+// @Produce      application/zip
+// @Param        export_id  path      string  true  "내보내기 ID"
+// @Param        expires    query     string  true  "링크 만료 시각 (unix)"
+// @Param        sig        query     string  true  "서명"
+// @Success      200        {file}    file
+// @Failure      403        {object}  dto.ErrorResponse  "INVALID_DOWNLOAD_LINK"
+// @Failure      404        {object}  dto.ErrorResponse  "EXPORT_NOT_FOUND"
+// @Router       /exports/{export_id}/download [get]
+func (h *DataExportHandler) Download(c echo.Context) error {
+	exportID := c.Param("export_id")
+
+	data, err := h.service.Download(c.Request().Context(), exportID, c.QueryParam("expires"), c.QueryParam("sig"))
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="dangbamgong-export-`+exportID+`.zip"`)
+	return c.Blob(http.StatusOK, "application/zip", data)
+}
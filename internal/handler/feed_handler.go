@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/middleware"
+	"dangbamgong-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type FeedHandler struct {
+	service service.FeedService
+}
+
+func NewFeedHandler(s service.FeedService) *FeedHandler {
+	return &FeedHandler{service: s}
+}
+
+// GetFeed godoc
+// @Summary      친구 피드 조회
+// @Description  친구들이 마친 공백, 연속 공백 기념일, 최고 기록 경신을 최신순으로 반환합니다.
+// @Description  공백 기록은 친구가 공개한 서클에게만, 연속·최고 기록은 통계를 볼 수 있을 때만 보이며, 활동은 친구가 활동 공개를 허용한 경우에만 포함됩니다. 끝난 공백에는 내가 남긴 반응이 함께 내려갑니다.
+// @Tags         Friends
+// @Produce      json
+// @Security     BearerAuth
+// @Param        cursor  query     string  false  "이전 응답의 nextCursor"
+// @Param        limit   query     int     false  "조회 개수 (기본 20, 최대 50)"
+// @Success      200  {object}  dto.Response[dto.FeedResponse]
+// @Failure      400  {object}  dto.ErrorResponse  "INVALID_CURSOR"
+// @Router       /friends/feed [get]
+func (h *FeedHandler) GetFeed(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	resp, err := h.service.GetFeed(c.Request().Context(), userID, c.QueryParam("cursor"), limit)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
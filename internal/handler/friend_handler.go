@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"dangbamgong-backend/internal/dto"
 	"dangbamgong-backend/internal/middleware"
@@ -20,16 +21,53 @@ func NewFriendHandler(s service.FriendService) *FriendHandler {
 
 // GetFriends godoc
 // @Summary      친구 목록 조회
-// @Description  친구 목록을 반환합니다. 각 친구의 공백 상태를 포함합니다.
+// @Description  친구 목록을 커서 페이지네이션으로 반환합니다. 각 친구의 공백 상태를 포함하며, 공백 상태를 나에게 공개하지 않은 친구는 공백 중이 아닌 것으로 보입니다.
+// @Description  다음 페이지는 nextCursor 를 같은 sort, q 와 함께 보내 조회합니다.
+// @Description  IN_VOID, RECENT 정렬은 페이지를 넘기는 사이 친구의 공백 상태가 바뀌면 순서가 달라져 일부 친구가 빠지거나 중복될 수 있습니다. 처음부터 다시 조회하면 정확한 목록을 받습니다.
 // @Tags         Friends
 // @Produce      json
 // @Security     BearerAuth
+// @Param        sort    query     string  false  "정렬 (FRIENDSHIP: 친구가 된 날짜 최신순(기본), IN_VOID: 공백 중인 친구 먼저, RECENT: 최근 활동순, NICKNAME: 닉네임순)"
+// @Param        q       query     string  false  "닉네임·태그 검색어"
+// @Param        cursor  query     string  false  "이전 응답의 nextCursor"
+// @Param        limit   query     int     false  "조회 개수 (기본 50, 최대 100)"
 // @Success      200  {object}  dto.Response[dto.FriendListResponse]
+// @Failure      400  {object}  dto.ErrorResponse  "INVALID_FRIEND_SORT / INVALID_CURSOR"
 // @Router       /friends [get]
 func (h *FriendHandler) GetFriends(c echo.Context) error {
 	userID := c.Get(middleware.ContextKeyUserID).(string)
 
-	resp, err := h.service.GetFriends(c.Request().Context(), userID)
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	query := dto.FriendListQuery{
+		Sort:   c.QueryParam("sort"),
+		Query:  c.QueryParam("q"),
+		Cursor: c.QueryParam("cursor"),
+		Limit:  limit,
+	}
+
+	resp, err := h.service.GetFriends(c.Request().Context(), userID, query)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// GetSuggestions godoc
+// @Summary      친구 추천
+// @Description  친구의 친구를 함께 아는 친구가 많은 순으로 추천합니다. 이미 친구이거나 요청이 오가는 중인 유저, 차단 관계인 유저, 숨긴 추천은 제외합니다.
+// @Tags         Friends
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit  query     int  false  "조회 개수 (기본 20, 최대 50)"
+// @Success      200  {object}  dto.Response[dto.FriendSuggestionListResponse]
+// @Router       /friends/suggestions [get]
+func (h *FriendHandler) GetSuggestions(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	resp, err := h.service.GetSuggestions(c.Request().Context(), userID, limit)
 	if err != nil {
 		return err
 	}
@@ -37,6 +75,28 @@ func (h *FriendHandler) GetFriends(c echo.Context) error {
 	return dto.Success(c, http.StatusOK, resp)
 }
 
+// DismissSuggestion godoc
+// @Summary      친구 추천 숨기기
+// @Description  해당 유저를 친구 추천에 다시 보여주지 않습니다
+// @Tags         Friends
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id  path  string  true  "숨길 유저 ID"
+// @Success      200  {object}  dto.Response[any]
+// @Failure      400  {object}  dto.ErrorResponse  "BAD_REQUEST"
+// @Failure      404  {object}  dto.ErrorResponse  "USER_NOT_FOUND"
+// @Router       /friends/suggestions/{user_id}/dismiss [post]
+func (h *FriendHandler) DismissSuggestion(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	targetID := c.Param("user_id")
+
+	if err := h.service.DismissSuggestion(c.Request().Context(), userID, targetID); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
 // RemoveFriend godoc
 // @Summary      친구 삭제
 // @Description  양방향 친구 관계를 삭제합니다
@@ -60,19 +120,21 @@ func (h *FriendHandler) RemoveFriend(c echo.Context) error {
 
 // GetRequests godoc
 // @Summary      친구 요청 목록 조회
-// @Description  받은(received) 또는 보낸(sent) 친구 요청 목록을 반환합니다
+// @Description  받은(received) 또는 보낸(sent) 친구 요청 목록을 반환합니다. status 를 생략하면 받은 요청은 PENDING 만, 보낸 요청은 PENDING 과 EXPIRED 를 반환합니다. 보낸 사람에게 거절된 요청은 만료될 때까지 PENDING 으로 보입니다. 응답이 없거나 거절된 요청은 일정 기간이 지나면 EXPIRED 가 되고, 만료된 요청은 일정 기간 후 삭제됩니다.
 // @Tags         Friends
 // @Produce      json
 // @Security     BearerAuth
-// @Param        type  query     string  true  "요청 타입"  Enums(received, sent)
-// @Success      200   {object}  dto.Response[dto.ReceivedRequestsResponse]  "type=received"
-// @Failure      400   {object}  dto.ErrorResponse  "INVALID_REQUEST_TYPE"
+// @Param        type    query     string  true   "요청 타입"  Enums(received, sent)
+// @Param        status  query     string  false  "쉼표로 구분한 상태 필터 (PENDING, ACCEPTED, REJECTED, EXPIRED)"
+// @Success      200     {object}  dto.Response[dto.ReceivedRequestsResponse]  "type=received"
+// @Failure      400     {object}  dto.ErrorResponse  "INVALID_REQUEST_TYPE / INVALID_REQUEST_STATUS"
 // @Router       /friends/requests [get]
 func (h *FriendHandler) GetRequests(c echo.Context) error {
 	userID := c.Get(middleware.ContextKeyUserID).(string)
 	reqType := c.QueryParam("type")
+	status := c.QueryParam("status")
 
-	resp, err := h.service.GetRequests(c.Request().Context(), userID, reqType)
+	resp, err := h.service.GetRequests(c.Request().Context(), userID, reqType, status)
 	if err != nil {
 		return err
 	}
@@ -82,7 +144,7 @@ func (h *FriendHandler) GetRequests(c echo.Context) error {
 
 // SendRequest godoc
 // @Summary      친구 요청 보내기
-// @Description  유저에게 친구 요청을 보냅니다. 이미 친구이거나 차단 관계이면 실패합니다.
+// @Description  유저에게 친구 요청을 보냅니다. 이미 친구이거나 차단 관계이면 실패합니다. 거절된 상대에게는 거절 후 일정 기간 동안 다시 요청할 수 없습니다.
 // @Tags         Friends
 // @Accept       json
 // @Produce      json
@@ -91,6 +153,7 @@ func (h *FriendHandler) GetRequests(c echo.Context) error {
 // @Success      201   {object}  dto.Response[dto.SendFriendRequestResponse]
 // @Failure      400   {object}  dto.ErrorResponse  "BLOCKED"
 // @Failure      409   {object}  dto.ErrorResponse  "ALREADY_FRIENDS / REQUEST_ALREADY_SENT"
+// @Failure      429   {object}  dto.ErrorResponse  "FRIEND_REQUEST_COOLDOWN / RATE_LIMITED (Retry-After, data.retryAfterSec)"
 // @Router       /friends/requests [post]
 func (h *FriendHandler) SendRequest(c echo.Context) error {
 	userID := c.Get(middleware.ContextKeyUserID).(string)
@@ -178,13 +241,14 @@ func (h *FriendHandler) DeleteRequest(c echo.Context) error {
 
 // Nudge godoc
 // @Summary      친구 찌르기
-// @Description  공백 중인 친구에게 알림을 보냅니다. 친구가 공백 중이 아니면 실패합니다.
+// @Description  공백 중인 친구에게 알림을 보냅니다. 친구가 공백 중이 아니면 실패합니다. 같은 친구는 일정 시간마다 한 번만 찌를 수 있고, 한 사람이 하루에 받을 수 있는 찌르기 수에도 제한이 있습니다.
 // @Tags         Friends
 // @Produce      json
 // @Security     BearerAuth
 // @Param        user_id  path  string  true  "찌를 친구 유저 ID"
 // @Success      200  {object}  dto.Response[any]
 // @Failure      400  {object}  dto.ErrorResponse  "NOT_FRIENDS / FRIEND_NOT_IN_VOID"
+// @Failure      429  {object}  dto.ErrorResponse  "NUDGE_COOLDOWN / NUDGE_DAILY_LIMIT / RATE_LIMITED (Retry-After, data.retryAfterSec)"
 // @Router       /friends/{user_id}/nudge [post]
 func (h *FriendHandler) Nudge(c echo.Context) error {
 	userID := c.Get(middleware.ContextKeyUserID).(string)
@@ -196,3 +260,77 @@ func (h *FriendHandler) Nudge(c echo.Context) error {
 
 	return dto.SuccessEmpty(c, http.StatusOK)
 }
+
+// GetNudges godoc
+// @Summary      오늘 밤 나를 찌른 친구
+// @Description  오늘(16시 기준) 나를 찌른 친구를 마지막으로 찌른 시각 최신순으로 반환합니다. 같은 친구가 여러 번 찌르면 횟수로 묶습니다.
+// @Tags         Friends
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[dto.NudgeListResponse]
+// @Router       /friends/nudges [get]
+func (h *FriendHandler) GetNudges(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	resp, err := h.service.GetNudges(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// CreateInvite godoc
+// @Summary      친구 초대 코드 생성
+// @Description  공유할 수 있는 친구 초대 코드와 링크를 만듭니다. 코드를 사용한 유저와는 친구 요청 없이 바로 친구가 됩니다. 기본 72시간, 10회까지 사용할 수 있습니다.
+// @Tags         Friends
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.CreateFriendInviteRequest  false  "유효 기간과 최대 사용 횟수"
+// @Success      201   {object}  dto.Response[dto.FriendInviteResponse]
+// @Failure      400   {object}  dto.ErrorResponse
+// @Router       /friends/invites [post]
+func (h *FriendHandler) CreateInvite(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	var req dto.CreateFriendInviteRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.CreateInvite(c.Request().Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusCreated, resp)
+}
+
+// RedeemInvite godoc
+// @Summary      친구 초대 코드 사용
+// @Description  초대 코드를 사용해 초대한 유저와 바로 친구가 됩니다. 두 유저 중 한쪽이라도 상대를 차단했으면 실패합니다.
+// @Tags         Friends
+// @Produce      json
+// @Security     BearerAuth
+// @Param        code  path      string  true  "초대 코드"
+// @Success      200   {object}  dto.Response[dto.RedeemFriendInviteResponse]
+// @Failure      400   {object}  dto.ErrorResponse  "INVITE_EXPIRED / INVITE_EXHAUSTED"
+// @Failure      403   {object}  dto.ErrorResponse  "BLOCKED"
+// @Failure      404   {object}  dto.ErrorResponse  "INVITE_NOT_FOUND"
+// @Failure      409   {object}  dto.ErrorResponse  "ALREADY_FRIENDS"
+// @Failure      429   {object}  dto.ErrorResponse  "RATE_LIMITED (Retry-After)"
+// @Router       /friends/invites/{code}/redeem [post]
+func (h *FriendHandler) RedeemInvite(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	resp, err := h.service.RedeemInvite(c.Request().Context(), userID, c.Param("code"))
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
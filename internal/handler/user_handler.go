@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"io"
 	"net/http"
 
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
 	"dangbamgong-backend/internal/dto"
 	"dangbamgong-backend/internal/middleware"
 	"dangbamgong-backend/internal/service"
@@ -11,11 +14,15 @@ import (
 )
 
 type UserHandler struct {
-	service service.UserService
+	service        service.UserService
+	avatarMaxBytes int64
 }
 
 func NewUserHandler(s service.UserService) *UserHandler {
-	return &UserHandler{service: s}
+	return &UserHandler{
+		service:        s,
+		avatarMaxBytes: int64(config.GetInt("AVATAR_MAX_BYTES", 10<<20)),
+	}
 }
 
 // Search godoc
@@ -27,6 +34,7 @@ func NewUserHandler(s service.UserService) *UserHandler {
 // @Param        tag  query     string  true  "태그 접두사"
 // @Success      200  {object}  dto.Response[dto.UserSearchResponse]
 // @Failure      400  {object}  dto.ErrorResponse
+// @Failure      429  {object}  dto.ErrorResponse  "RATE_LIMITED (Retry-After)"
 // @Router       /users/search [get]
 func (h *UserHandler) Search(c echo.Context) error {
 	userID := c.Get(middleware.ContextKeyUserID).(string)
@@ -85,6 +93,58 @@ func (h *UserHandler) UpdateSettings(c echo.Context) error {
 	return dto.Success(c, http.StatusOK, resp)
 }
 
+// UpdatePrivacy godoc
+// @Summary      공개 범위 설정 변경
+// @Description  프로필 통계(연속 공백 일수, 이번 주 총 공백 시간)의 공개 범위를 변경합니다 (EVERYONE, FRIENDS, NOBODY).
+// @Description  통계·공백 상태·피드의 공백 기록을 볼 수 있는 친구를 서클로 좁힐 수 있으며, 빈 배열이면 모든 친구에게 공개합니다.
+// @Description  shareActivities 를 켜면 피드의 공백 기록에 활동도 함께 보입니다.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.UpdatePrivacyRequest  true  "변경할 설정"
+// @Success      200   {object}  dto.Response[dto.PrivacySettings]
+// @Failure      400   {object}  dto.ErrorResponse  "INVALID_PRIVACY_SETTING"
+// @Failure      404   {object}  dto.ErrorResponse  "CIRCLE_NOT_FOUND"
+// @Router       /users/me/privacy [patch]
+func (h *UserHandler) UpdatePrivacy(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	var req dto.UpdatePrivacyRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.UpdatePrivacy(c.Request().Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// GetProfile godoc
+// @Summary      유저 프로필 조회
+// @Description  다른 유저의 프로필과 친구 관계 상태를 반환합니다. 통계는 대상 유저의 공개 범위에 따라 null일 수 있습니다. 나를 차단한 유저는 조회할 수 없습니다.
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Param        user_id  path      string  true  "유저 ID"
+// @Success      200      {object}  dto.Response[dto.UserProfileResponse]
+// @Failure      404      {object}  dto.ErrorResponse  "USER_NOT_FOUND"
+// @Router       /users/{user_id} [get]
+func (h *UserHandler) GetProfile(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	targetID := c.Param("user_id")
+
+	resp, err := h.service.GetProfile(c.Request().Context(), userID, targetID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
 // GetBlocks godoc
 // @Summary      차단 목록 조회
 // @Description  내가 차단한 유저 목록을 반환합니다
@@ -128,14 +188,16 @@ func (h *UserHandler) Block(c echo.Context) error {
 
 // ChangeNickname godoc
 // @Summary      닉네임 변경
-// @Description  기존 닉네임을 변경합니다. 최초 설정은 /auth/nickname을 사용하세요.
+// @Description  기존 닉네임을 변경합니다. 최초 설정은 /auth/nickname을 사용하세요. 변경 후 일정 기간 동안 다시 변경할 수 없습니다.
 // @Tags         Users
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        body  body      dto.ChangeNicknameRequest  true  "새 닉네임 (3-15자)"
 // @Success      200   {object}  dto.Response[dto.ChangeNicknameResponse]
-// @Failure      400   {object}  dto.ErrorResponse  "INVALID_NICKNAME"
+// @Failure      400   {object}  dto.ErrorResponse  "INVALID_NICKNAME / NICKNAME_NOT_ALLOWED"
+// @Failure      403   {object}  dto.ErrorResponse  "NICKNAME_CHANGE_COOLDOWN (data.nextChangeAt)"
+// @Failure      409   {object}  dto.ErrorResponse  "CONFLICT (동시에 들어온 다른 변경 요청이 먼저 반영됨)"
 // @Router       /users/me/nickname [patch]
 func (h *UserHandler) ChangeNickname(c echo.Context) error {
 	userID := c.Get(middleware.ContextKeyUserID).(string)
@@ -153,6 +215,98 @@ func (h *UserHandler) ChangeNickname(c echo.Context) error {
 	return dto.Success(c, http.StatusOK, resp)
 }
 
+// ChangeTag godoc
+// @Summary      태그 변경
+// @Description  랜덤 태그 대신 원하는 태그(영문/숫자 4-12자)를 설정합니다. 대소문자는 구분하지 않으며, 변경 전 태그는 일정 기간 예약됩니다.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.ChangeTagRequest  true  "새 태그"
+// @Success      200   {object}  dto.Response[dto.ChangeTagResponse]
+// @Failure      400   {object}  dto.ErrorResponse  "INVALID_TAG"
+// @Failure      403   {object}  dto.ErrorResponse  "TAG_CHANGE_COOLDOWN (data.nextChangeAt)"
+// @Failure      409   {object}  dto.ErrorResponse  "TAG_ALREADY_TAKEN"
+// @Router       /users/me/tag [patch]
+func (h *UserHandler) ChangeTag(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	var req dto.ChangeTagRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.ChangeTag(c.Request().Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// UploadAvatar godoc
+// @Summary      아바타 업로드
+// @Description  JPEG/PNG 이미지를 업로드합니다. (HEIC 는 클라이언트에서 JPEG 로 변환) EXIF 를 제거하고 정사각형 썸네일(128, 512)로 저장합니다.
+// @Tags         Users
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        image  formData  file  true  "아바타 이미지"
+// @Success      200    {object}  dto.Response[dto.AvatarResponse]
+// @Failure      400    {object}  dto.ErrorResponse  "INVALID_IMAGE"
+// @Failure      413    {object}  dto.ErrorResponse  "IMAGE_TOO_LARGE (파일 크기 초과, 가로·세로 8000px 또는 전체 4096×4096px 초과)"
+// @Router       /users/me/avatar [put]
+func (h *UserHandler) UploadAvatar(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		return domain.NewBadRequest(domain.ErrInvalidImage, "image is required")
+	}
+	if file.Size > h.avatarMaxBytes {
+		return domain.NewPayloadTooLarge(domain.ErrImageTooLarge, "image file too large")
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return domain.NewBadRequest(domain.ErrInvalidImage, "failed to read image")
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(io.LimitReader(src, h.avatarMaxBytes+1))
+	if err != nil {
+		return domain.NewBadRequest(domain.ErrInvalidImage, "failed to read image")
+	}
+	if int64(len(data)) > h.avatarMaxBytes {
+		return domain.NewPayloadTooLarge(domain.ErrImageTooLarge, "image file too large")
+	}
+
+	resp, err := h.service.UploadAvatar(c.Request().Context(), userID, data)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// DeleteAvatar godoc
+// @Summary      아바타 삭제
+// @Description  등록된 아바타를 삭제합니다
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[any]
+// @Router       /users/me/avatar [delete]
+func (h *UserHandler) DeleteAvatar(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	if err := h.service.DeleteAvatar(c.Request().Context(), userID); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
 // Unblock godoc
 // @Summary      유저 차단 해제
 // @Description  차단을 해제합니다
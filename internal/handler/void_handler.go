@@ -40,7 +40,7 @@ func (h *VoidHandler) Start(c echo.Context) error {
 
 // End godoc
 // @Summary      공백 종료
-// @Description  진행 중인 공백 세션을 종료하고 활동을 기록합니다. 활동은 최대 5개.
+// @Description  진행 중인 공백 세션을 종료하고 활동을 기록합니다. 활동은 최대 5개. 공백 방에 들어가 있으면 세션이 그 방에 연결됩니다.
 // @Tags         Void
 // @Accept       json
 // @Produce      json
@@ -115,7 +115,7 @@ func (h *VoidHandler) TestCreate(c echo.Context) error {
 
 // History godoc
 // @Summary      공백 히스토리 조회
-// @Description  특정 날짜의 공백 세션 목록과 총 시간을 반환합니다. 날짜 기준은 KST 16:00.
+// @Description  특정 날짜의 공백 세션 목록과 총 시간을 반환합니다. 날짜 기준은 KST 16:00. 세션마다 친구들이 남긴 반응 수를 이모지별로 함께 반환합니다.
 // @Tags         Void
 // @Produce      json
 // @Security     BearerAuth
@@ -133,3 +133,53 @@ func (h *VoidHandler) History(c echo.Context) error {
 
 	return dto.Success(c, http.StatusOK, resp)
 }
+
+// React godoc
+// @Summary      공백 반응 남기기
+// @Description  친구의 끝난 공백에 반응을 남깁니다. 세션마다 하나만 남길 수 있으며, 다시 보내면 반응이 바뀝니다. 반응은 모아서 세션 주인에게 알림을 보냅니다.
+// @Tags         Void
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        session_id  path  string                      true  "세션 ID"
+// @Param        body        body  dto.SessionReactionRequest  true  "반응 (CLAP, FIRE, HEART, MOON, COFFEE)"
+// @Success      200  {object}  dto.Response[any]
+// @Failure      400  {object}  dto.ErrorResponse  "INVALID_REACTION"
+// @Failure      404  {object}  dto.ErrorResponse  "SESSION_NOT_FOUND"
+// @Router       /void/sessions/{session_id}/reaction [put]
+func (h *VoidHandler) React(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	sessionID := c.Param("session_id")
+
+	var req dto.SessionReactionRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := h.service.React(c.Request().Context(), userID, sessionID, req); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
+// RemoveReaction godoc
+// @Summary      공백 반응 취소
+// @Description  친구의 공백에 남긴 반응을 지웁니다
+// @Tags         Void
+// @Produce      json
+// @Security     BearerAuth
+// @Param        session_id  path  string  true  "세션 ID"
+// @Success      200  {object}  dto.Response[any]
+// @Failure      404  {object}  dto.ErrorResponse  "SESSION_NOT_FOUND"
+// @Router       /void/sessions/{session_id}/reaction [delete]
+func (h *VoidHandler) RemoveReaction(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	sessionID := c.Param("session_id")
+
+	if err := h.service.RemoveReaction(c.Request().Context(), userID, sessionID); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
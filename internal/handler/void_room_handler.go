@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"net/http"
+
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/middleware"
+	"dangbamgong-backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type VoidRoomHandler struct {
+	service service.VoidRoomService
+}
+
+func NewVoidRoomHandler(s service.VoidRoomService) *VoidRoomHandler {
+	return &VoidRoomHandler{service: s}
+}
+
+// Create godoc
+// @Summary      공백 방 만들기
+// @Description  친구들과 함께 공백할 방을 만들고 친구를 초대합니다. 만든 사람은 바로 방에 들어가며, 한 번에 한 방에만 있을 수 있습니다.
+// @Description  방에 있는 동안 끝낸 공백은 방에 연결되고, 방 멤버끼리는 공개 범위 설정과 관계없이 서로의 공백 여부와 시간이 보입니다.
+// @Tags         Void
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        body  body      dto.CreateVoidRoomRequest  true  "초대할 친구 유저 ID 목록"
+// @Success      201   {object}  dto.Response[dto.VoidRoomResponse]
+// @Failure      400   {object}  dto.ErrorResponse  "ROOM_INVITEE_NOT_FRIEND"
+// @Failure      409   {object}  dto.ErrorResponse  "ALREADY_IN_ROOM"
+// @Router       /void/rooms [post]
+func (h *VoidRoomHandler) Create(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	var req dto.CreateVoidRoomRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	resp, err := h.service.Create(c.Request().Context(), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusCreated, resp)
+}
+
+// GetCurrent godoc
+// @Summary      내 공백 방 조회
+// @Description  지금 들어가 있는 방과 멤버별 공백 여부, 진행 시간, 방에서 공백한 시간을 반환합니다.
+// @Tags         Void
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  dto.Response[dto.VoidRoomResponse]
+// @Failure      404  {object}  dto.ErrorResponse  "ROOM_NOT_FOUND"
+// @Router       /void/rooms/current [get]
+func (h *VoidRoomHandler) GetCurrent(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+
+	resp, err := h.service.GetCurrent(c.Request().Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// Get godoc
+// @Summary      공백 방 조회
+// @Description  들어갔던 방이나 초대받은 열린 방을 반환합니다. 멤버별 공백 여부와 진행 시간은 방 멤버에게만 보입니다. 닫힌 방은 닫힐 때의 합계를 보여줍니다.
+// @Tags         Void
+// @Produce      json
+// @Security     BearerAuth
+// @Param        room_id  path  string  true  "방 ID"
+// @Success      200  {object}  dto.Response[dto.VoidRoomResponse]
+// @Failure      404  {object}  dto.ErrorResponse  "ROOM_NOT_FOUND"
+// @Router       /void/rooms/{room_id} [get]
+func (h *VoidRoomHandler) Get(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	roomID := c.Param("room_id")
+
+	resp, err := h.service.Get(c.Request().Context(), userID, roomID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// Invite godoc
+// @Summary      공백 방 초대
+// @Description  방 멤버가 자기 친구를 방에 초대합니다. 이미 초대받은 친구에게는 알림을 다시 보내지 않습니다.
+// @Tags         Void
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        room_id  path  string                     true  "방 ID"
+// @Param        body     body  dto.InviteVoidRoomRequest  true  "초대할 친구 유저 ID 목록"
+// @Success      200  {object}  dto.Response[any]
+// @Failure      400  {object}  dto.ErrorResponse  "ROOM_INVITEE_NOT_FRIEND"
+// @Failure      404  {object}  dto.ErrorResponse  "ROOM_NOT_FOUND"
+// @Router       /void/rooms/{room_id}/invite [post]
+func (h *VoidRoomHandler) Invite(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	roomID := c.Param("room_id")
+
+	var req dto.InviteVoidRoomRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if err := h.service.Invite(c.Request().Context(), userID, roomID, req); err != nil {
+		return err
+	}
+
+	return dto.SuccessEmpty(c, http.StatusOK)
+}
+
+// Join godoc
+// @Summary      공백 방 들어가기
+// @Description  초대받은 방에 들어갑니다. 방 멤버에게 알림을 보냅니다.
+// @Tags         Void
+// @Produce      json
+// @Security     BearerAuth
+// @Param        room_id  path  string  true  "방 ID"
+// @Success      200  {object}  dto.Response[dto.VoidRoomResponse]
+// @Failure      404  {object}  dto.ErrorResponse  "ROOM_NOT_FOUND"
+// @Failure      409  {object}  dto.ErrorResponse  "ALREADY_IN_ROOM / ROOM_FULL"
+// @Router       /void/rooms/{room_id}/join [post]
+func (h *VoidRoomHandler) Join(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	roomID := c.Param("room_id")
+
+	resp, err := h.service.Join(c.Request().Context(), userID, roomID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
+
+// Leave godoc
+// @Summary      공백 방 나가기
+// @Description  방에서 나갑니다. 공백 중에 나가면 그 공백은 방에 연결되지 않습니다. 마지막 멤버가 나가면 방이 닫히고 방 전체 합계가 반환됩니다. 공백 중인 멤버 없이 VOID_ROOM_IDLE_TIMEOUT(기본 6시간) 동안 활동이 없는 방도 자동으로 닫힙니다.
+// @Tags         Void
+// @Produce      json
+// @Security     BearerAuth
+// @Param        room_id  path  string  true  "방 ID"
+// @Success      200  {object}  dto.Response[dto.VoidRoomResponse]
+// @Failure      404  {object}  dto.ErrorResponse  "ROOM_NOT_FOUND"
+// @Router       /void/rooms/{room_id}/leave [post]
+func (h *VoidRoomHandler) Leave(c echo.Context) error {
+	userID := c.Get(middleware.ContextKeyUserID).(string)
+	roomID := c.Param("room_id")
+
+	resp, err := h.service.Leave(c.Request().Context(), userID, roomID)
+	if err != nil {
+		return err
+	}
+
+	return dto.Success(c, http.StatusOK, resp)
+}
@@ -0,0 +1,174 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	maxDimension   = 8000        // 디코딩 전에 거부할 최대 가로/세로 픽셀
+	maxPixels      = 4096 * 4096 // 디코딩 전에 거부할 최대 전체 픽셀 수 (RGBA 기준 약 64MB)
+	maxConcurrency = 2           // 동시에 디코딩할 수 있는 이미지 수
+	jpegQuality    = 85
+)
+
+// 큰 이미지 여러 장이 한꺼번에 디코딩되어 메모리가 튀지 않도록 동시 처리 수를 제한
+var decodeSlots = make(chan struct{}, maxConcurrency)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooLarge          = errors.New("image dimensions too large")
+)
+
+// SquareThumbnails 는 JPEG/PNG 이미지를 가운데 기준 정사각형으로 자르고 sizes 별 JPEG 썸네일을 만든다.
+// 다시 인코딩하므로 EXIF 등 메타데이터는 모두 제거되며, 제거 전에 EXIF 회전 정보는 반영한다.
+func SquareThumbnails(data []byte, sizes []int) (map[int][]byte, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png") {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width > maxDimension || cfg.Height > maxDimension || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	decodeSlots <- struct{}{}
+	defer func() { <-decodeSlots }()
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	orientation := 1
+	if format == "jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	crop := centerSquare(src.Bounds())
+
+	result := make(map[int][]byte, len(sizes))
+	for _, size := range sizes {
+		dst := image.NewRGBA(image.Rect(0, 0, size, size))
+		// 투명 PNG 는 흰 배경 위에 합성
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.CatmullRom.Scale(dst, dst.Bounds(), src, crop, draw.Over, nil)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, orient(dst, orientation), &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+		result[size] = buf.Bytes()
+	}
+	return result, nil
+}
+
+func centerSquare(b image.Rectangle) image.Rectangle {
+	side := min(b.Dx(), b.Dy())
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	return image.Rect(x, y, x+side, y+side)
+}
+
+// orient 는 정사각형 이미지에 EXIF Orientation(1-8)을 적용한다.
+// 가운데 기준 정사각형은 회전/반전해도 같은 영역이므로 자른 뒤에 적용해도 결과가 같다.
+func orient(img *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	n := img.Bounds().Dx()
+	dst := image.NewRGBA(img.Bounds())
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 좌우 반전
+				dx, dy = n-1-x, y
+			case 3: // 180도 회전
+				dx, dy = n-1-x, n-1-y
+			case 4: // 상하 반전
+				dx, dy = x, n-1-y
+			case 5: // 주대각선 기준 반전
+				dx, dy = y, x
+			case 6: // 시계 방향 90도 회전
+				dx, dy = n-1-y, x
+			case 7: // 부대각선 기준 반전
+				dx, dy = n-1-y, n-1-x
+			case 8: // 반시계 방향 90도 회전
+				dx, dy = y, n-1-x
+			}
+			dst.SetRGBA(dx, dy, img.RGBAAt(x, y))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation 은 JPEG 의 APP1(Exif) 세그먼트에서 Orientation 태그를 읽는다. 없으면 1.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 { // SOS, EOI
+			return 1
+		}
+		length := int(data[pos+2])<<8 | int(data[pos+3])
+		if length < 2 || pos+2+length > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var u16 func([]byte) int
+	var u32 func([]byte) int
+	switch string(tiff[:2]) {
+	case "II":
+		u16 = func(b []byte) int { return int(b[0]) | int(b[1])<<8 }
+		u32 = func(b []byte) int { return int(b[0]) | int(b[1])<<8 | int(b[2])<<16 | int(b[3])<<24 }
+	case "MM":
+		u16 = func(b []byte) int { return int(b[0])<<8 | int(b[1]) }
+		u32 = func(b []byte) int { return int(b[0])<<24 | int(b[1])<<16 | int(b[2])<<8 | int(b[3]) }
+	default:
+		return 1
+	}
+
+	ifd := u32(tiff[4:8])
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+
+	count := u16(tiff[ifd : ifd+2])
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if u16(tiff[entry:entry+2]) == 0x0112 {
+			return u16(tiff[entry+8 : entry+10])
+		}
+	}
+	return 1
+}
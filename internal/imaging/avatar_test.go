@@ -0,0 +1,229 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// exifSegment 는 Orientation 태그 하나만 있는 APP1(Exif) 세그먼트를 만든다.
+func exifSegment(order binary.ByteOrder, tag uint16, orientation uint16) []byte {
+	tiff := make([]byte, 26)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)    // IFD0 위치
+	order.PutUint16(tiff[8:], 1)    // 항목 수
+	order.PutUint16(tiff[10:], tag) // 태그
+	order.PutUint16(tiff[12:], 3)   // SHORT
+	order.PutUint32(tiff[14:], 1)   // 개수
+	order.PutUint16(tiff[18:], orientation)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// withSegments 는 JPEG 의 SOI 바로 뒤에 세그먼트를 끼워 넣는다.
+func withSegments(jpg []byte, segments ...[]byte) []byte {
+	result := append([]byte{}, jpg[:2]...)
+	for _, s := range segments {
+		result = append(result, s...)
+	}
+	return append(result, jpg[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("jpeg.Encode() error: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	base := encodeJPEG(t, image.NewRGBA(image.Rect(0, 0, 8, 8)))
+	app0 := []byte{0xFF, 0xE0, 0x00, 0x04, 0x00, 0x00}
+	xmp := append([]byte{0xFF, 0xE1, 0x00, 0x0D}, "http://ns.a"...)
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "no exif", data: base, want: 1},
+		{name: "little endian", data: withSegments(base, exifSegment(binary.LittleEndian, 0x0112, 6)), want: 6},
+		{name: "big endian", data: withSegments(base, exifSegment(binary.BigEndian, 0x0112, 8)), want: 8},
+		{name: "after app0", data: withSegments(base, app0, exifSegment(binary.BigEndian, 0x0112, 3)), want: 3},
+		{name: "after xmp app1", data: withSegments(base, xmp, exifSegment(binary.LittleEndian, 0x0112, 5)), want: 5},
+		{name: "other tag only", data: withSegments(base, exifSegment(binary.LittleEndian, 0x010F, 6)), want: 1},
+		{name: "not jpeg", data: []byte("\x89PNG\r\n\x1a\n"), want: 1},
+		{name: "empty", data: nil, want: 1},
+		{name: "truncated segment", data: withSegments(base, exifSegment(binary.LittleEndian, 0x0112, 6))[:20], want: 1},
+		{name: "truncated header", data: []byte{0xFF, 0xD8, 0xFF}, want: 1},
+		{name: "segment length too short", data: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, 0x01, 0x00, 0x00}, want: 1},
+		{name: "garbage after soi", data: []byte{0xFF, 0xD8, 0x00, 0x00, 0x00, 0x00}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != tt.want {
+				t.Errorf("jpegOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExifOrientationMalformed(t *testing.T) {
+	valid := exifSegment(binary.LittleEndian, 0x0112, 6)[10:] // APP1 헤더와 "Exif\0\0" 을 뺀 TIFF
+
+	mutate := func(f func([]byte)) []byte {
+		tiff := append([]byte{}, valid...)
+		f(tiff)
+		return tiff
+	}
+
+	tests := []struct {
+		name string
+		tiff []byte
+		want int
+	}{
+		{name: "valid", tiff: valid, want: 6},
+		{name: "too short", tiff: valid[:7], want: 1},
+		{name: "unknown byte order", tiff: mutate(func(b []byte) { copy(b, "XX") }), want: 1},
+		{name: "ifd offset past end", tiff: mutate(func(b []byte) { binary.LittleEndian.PutUint32(b[4:], 1000) }), want: 1},
+		{name: "ifd offset inside header", tiff: mutate(func(b []byte) { binary.LittleEndian.PutUint32(b[4:], 2) }), want: 1},
+		{name: "entry count past end", tiff: mutate(func(b []byte) {
+			binary.LittleEndian.PutUint16(b[8:], 50)
+			binary.LittleEndian.PutUint16(b[10:], 0x010F)
+		}), want: 1},
+		{name: "entry cut off", tiff: valid[:15], want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exifOrientation(tt.tiff); got != tt.want {
+				t.Errorf("exifOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	a := color.RGBA{R: 1, A: 255}
+	b := color.RGBA{R: 2, A: 255}
+	c := color.RGBA{R: 3, A: 255}
+	d := color.RGBA{R: 4, A: 255}
+
+	// 저장된 이미지는 A B / C D, want 는 화면에 보일 순서 (왼쪽 위, 오른쪽 위, 왼쪽 아래, 오른쪽 아래)
+	tests := []struct {
+		orientation int
+		want        [4]color.RGBA
+	}{
+		{orientation: 0, want: [4]color.RGBA{a, b, c, d}},
+		{orientation: 1, want: [4]color.RGBA{a, b, c, d}},
+		{orientation: 2, want: [4]color.RGBA{b, a, d, c}},
+		{orientation: 3, want: [4]color.RGBA{d, c, b, a}},
+		{orientation: 4, want: [4]color.RGBA{c, d, a, b}},
+		{orientation: 5, want: [4]color.RGBA{a, c, b, d}},
+		{orientation: 6, want: [4]color.RGBA{c, a, d, b}},
+		{orientation: 7, want: [4]color.RGBA{d, b, c, a}},
+		{orientation: 8, want: [4]color.RGBA{b, d, a, c}},
+		{orientation: 9, want: [4]color.RGBA{a, b, c, d}},
+	}
+
+	for _, tt := range tests {
+		img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+		img.SetRGBA(0, 0, a)
+		img.SetRGBA(1, 0, b)
+		img.SetRGBA(0, 1, c)
+		img.SetRGBA(1, 1, d)
+
+		got := orient(img, tt.orientation)
+		gotPixels := [4]color.RGBA{got.RGBAAt(0, 0), got.RGBAAt(1, 0), got.RGBAAt(0, 1), got.RGBAAt(1, 1)}
+		if gotPixels != tt.want {
+			t.Errorf("orient(%d) = %v, want %v", tt.orientation, gotPixels, tt.want)
+		}
+	}
+}
+
+func TestSquareThumbnailsAppliesOrientation(t *testing.T) {
+	// 왼쪽 위 사분면만 빨간 이미지. 6(시계 방향 90도)이면 오른쪽 위로 가야 함
+	src := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 64; x++ {
+			if x < 32 && y < 32 {
+				src.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				src.Set(x, y, color.RGBA{A: 255})
+			}
+		}
+	}
+	data := withSegments(encodeJPEG(t, src), exifSegment(binary.BigEndian, 0x0112, 6))
+
+	thumbs, err := SquareThumbnails(data, []int{16})
+	if err != nil {
+		t.Fatalf("SquareThumbnails() error: %v", err)
+	}
+	if jpegOrientation(thumbs[16]) != 1 {
+		t.Error("thumbnail still carries an exif orientation")
+	}
+
+	out, err := jpeg.Decode(bytes.NewReader(thumbs[16]))
+	if err != nil {
+		t.Fatalf("jpeg.Decode() error: %v", err)
+	}
+	red := func(x, y int) bool {
+		r, g, b, _ := out.At(x, y).RGBA()
+		return r > 0xC000 && g < 0x4000 && b < 0x4000
+	}
+	if !red(12, 4) || red(4, 4) || red(4, 12) || red(12, 12) {
+		t.Error("red quadrant is not at the top right after orientation 6")
+	}
+}
+
+func TestSquareThumbnailsRejects(t *testing.T) {
+	var png1 bytes.Buffer
+	if err := png.Encode(&png1, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatalf("png.Encode() error: %v", err)
+	}
+
+	// 디코딩 없이 헤더만으로 거부되는지 확인하려고 IHDR 의 크기만 바꾼 PNG 를 사용
+	pngWithSize := func(w, h uint32) []byte {
+		data := append([]byte{}, png1.Bytes()...)
+		binary.BigEndian.PutUint32(data[16:], w)
+		binary.BigEndian.PutUint32(data[20:], h)
+		binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+		return data
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{name: "not an image", data: []byte("hello"), want: ErrUnsupportedFormat},
+		{name: "too wide", data: pngWithSize(maxDimension+1, 10), want: ErrTooLarge},
+		{name: "too many pixels", data: pngWithSize(5000, 5000), want: ErrTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SquareThumbnails(tt.data, []int{16}); !errors.Is(err, tt.want) {
+				t.Errorf("SquareThumbnails() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
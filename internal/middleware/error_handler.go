@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"dangbamgong-backend/internal/domain"
 	"dangbamgong-backend/internal/dto"
@@ -22,6 +23,13 @@ func ErrorHandler(err error, c echo.Context) {
 	if errors.As(err, &appErr) {
 		fmt.Printf("\033[33m[AppError] %d | %s | %s\033[0m\n",
 			appErr.StatusCode, appErr.Code, appErr.Message)
+		if appErr.RetryAfter > 0 {
+			c.Response().Header().Set("Retry-After", strconv.FormatInt(domain.RetryAfterSeconds(appErr.RetryAfter), 10))
+		}
+		if appErr.Data != nil {
+			_ = dto.FailWithData(c, appErr.StatusCode, appErr.Code, appErr.Data)
+			return
+		}
 		_ = dto.Fail(c, appErr.StatusCode, appErr.Code)
 		return
 	}
@@ -58,6 +66,8 @@ func mapHTTPStatusToErrorCode(status int) domain.ErrorCode {
 		return domain.ErrForbidden
 	case http.StatusConflict:
 		return domain.ErrConflict
+	case http.StatusTooManyRequests:
+		return domain.ErrRateLimited
 	default:
 		return domain.ErrInternalServer
 	}
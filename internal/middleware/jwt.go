@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"strings"
 
 	"dangbamgong-backend/internal/auth"
@@ -9,9 +10,17 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-const ContextKeyUserID = "user_id"
+const (
+	ContextKeyUserID    = "user_id"
+	ContextKeySessionID = "session_id"
+)
+
+// SessionValidator 는 로그아웃·탈퇴·정지로 폐기된 세션의 토큰을 거부한다.
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, userID string, sessionID string) error
+}
 
-func JWTAuth() echo.MiddlewareFunc {
+func JWTAuth(sessions SessionValidator) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			header := c.Request().Header.Get("Authorization")
@@ -25,7 +34,15 @@ func JWTAuth() echo.MiddlewareFunc {
 				return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid or expired token: "+err.Error())
 			}
 
+			if claims.SessionID == "" {
+				return domain.NewUnauthorized(domain.ErrUnauthorized, "token has no session")
+			}
+			if err := sessions.ValidateSession(c.Request().Context(), claims.UserID, claims.SessionID); err != nil {
+				return err
+			}
+
 			c.Set(ContextKeyUserID, claims.UserID)
+			c.Set(ContextKeySessionID, claims.SessionID)
 			return next(c)
 		}
 	}
@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RateLimitStore 는 키별 토큰 버킷을 관리한다.
+// 버킷 크기는 limit, window 동안 limit 개가 다시 채워진다.
+// 요청이 허용되면 0 을, 거부되면 다음 토큰이 채워질 때까지 남은 시간을 반환한다.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error)
+}
+
+// RateLimitKeyFunc 는 요청을 어느 버킷에 셀지 결정한다.
+type RateLimitKeyFunc func(c echo.Context) string
+
+// IPExtractor 는 c.RealIP() 가 클라이언트 IP 를 어디서 읽을지 정한다.
+// 기본값은 연결된 주소이며, 프록시 뒤에 있으면 TRUSTED_PROXIES(CIDR 목록, 예: "10.0.0.0/8")를 설정한다.
+// 그 범위에서 온 X-Forwarded-For 만 믿으므로 클라이언트가 헤더를 꾸며 IP 별 제한을 피할 수 없다.
+func IPExtractor() echo.IPExtractor {
+	var options []echo.TrustOption
+	for _, cidr := range config.GetList("TRUSTED_PROXIES") {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Printf("[CONFIG] invalid CIDR in TRUSTED_PROXIES: %q, ignoring\n", cidr)
+			continue
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	if len(options) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	// 기본으로 믿는 루프백·사설망 대신 설정한 범위만 믿음
+	options = append(options, echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false))
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// KeyByIP 는 클라이언트 IP 별로 제한한다. 로그인 전 요청에 사용한다. IPExtractor 를 설정해야 한다.
+func KeyByIP(c echo.Context) string {
+	return "ip:" + c.RealIP()
+}
+
+// KeyByUser 는 로그인한 유저별로 제한한다. JWTAuth 뒤에 두어야 하며, 유저 ID 가 없으면 IP 로 대신한다.
+func KeyByUser(c echo.Context) string {
+	if userID, ok := c.Get(ContextKeyUserID).(string); ok && userID != "" {
+		return "user:" + userID
+	}
+	return KeyByIP(c)
+}
+
+// RateLimitPolicy 는 라우트별 제한 정책이다.
+// RATE_LIMIT_<NAME> 환경변수로 덮어쓸 수 있다 (예: RATE_LIMIT_LOGIN="20/1m", 끄려면 "off").
+type RateLimitPolicy struct {
+	Name   string
+	Limit  int           // Window 동안 허용하는 요청 수
+	Window time.Duration // 버킷이 가득 차는 데 걸리는 시간
+	Key    RateLimitKeyFunc
+}
+
+// RateLimit 은 정책을 넘긴 요청을 429 RATE_LIMITED 로 거부한다.
+// 저장소 장애로 서비스 전체가 막히지 않도록 저장소 오류는 로그만 남기고 통과시킨다.
+func RateLimit(store RateLimitStore, policy RateLimitPolicy) echo.MiddlewareFunc {
+	policy = loadRateLimitPolicy(policy)
+	if policy.Limit <= 0 || policy.Window <= 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+	}
+	if policy.Key == nil {
+		policy.Key = KeyByIP
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := policy.Name + ":" + policy.Key(c)
+			retryAfter, err := store.Take(c.Request().Context(), key, policy.Limit, policy.Window)
+			if err != nil {
+				log.Printf("[RATELIMIT] failed to take token for %s: %v\n", key, err)
+				return next(c)
+			}
+			if retryAfter > 0 {
+				return domain.NewTooManyRequests(domain.ErrRateLimited, "rate limit exceeded: "+policy.Name).
+					WithRetryAfter(retryAfter)
+			}
+			return next(c)
+		}
+	}
+}
+
+func loadRateLimitPolicy(policy RateLimitPolicy) RateLimitPolicy {
+	envKey := "RATE_LIMIT_" + strings.ToUpper(policy.Name)
+	v := strings.TrimSpace(os.Getenv(envKey))
+	if v == "" {
+		return policy
+	}
+	if strings.EqualFold(v, "off") {
+		policy.Limit = 0
+		return policy
+	}
+
+	limit, window, err := parseRateLimit(v)
+	if err != nil {
+		log.Printf("[CONFIG] invalid rate limit for %s: %q (%v), using default %d/%v\n", envKey, v, err, policy.Limit, policy.Window)
+		return policy
+	}
+	policy.Limit = limit
+	policy.Window = window
+	return policy
+}
+
+// parseRateLimit 은 "20/1m" 형식을 읽는다.
+func parseRateLimit(v string) (int, time.Duration, error) {
+	countStr, windowStr, ok := strings.Cut(v, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("expected <count>/<duration>")
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(countStr))
+	if err != nil || limit <= 0 {
+		return 0, 0, fmt.Errorf("invalid count")
+	}
+	window, err := time.ParseDuration(strings.TrimSpace(windowStr))
+	if err != nil || window <= 0 {
+		return 0, 0, fmt.Errorf("invalid duration")
+	}
+	return limit, window, nil
+}
+
+const rateLimitSweepInterval = time.Minute
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	window    time.Duration
+}
+
+// memoryRateLimitStore 는 프로세스 메모리에 버킷을 둔다. 인스턴스가 여러 대면 인스턴스마다 따로 센다.
+type memoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: make(map[string]*tokenBucket), lastSweep: time.Now()}
+}
+
+func (s *memoryRateLimitStore) Take(_ context.Context, key string, limit int, window time.Duration) (time.Duration, error) {
+	now := time.Now()
+	perToken := window / time.Duration(limit)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(limit), updatedAt: now}
+		s.buckets[key] = b
+	}
+	b.window = window
+
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = min(float64(limit), b.tokens+float64(elapsed)/float64(perToken))
+	}
+	b.updatedAt = now
+
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) * float64(perToken)), nil
+	}
+	b.tokens--
+	return 0, nil
+}
+
+// sweep 은 가득 찬 상태로 돌아간 버킷을 지워 IP 별 버킷이 계속 쌓이지 않게 한다.
+func (s *memoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if now.Sub(b.updatedAt) >= b.window {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		in      string
+		limit   int
+		window  time.Duration
+		wantErr bool
+	}{
+		{in: "20/1m", limit: 20, window: time.Minute},
+		{in: " 5 / 30s ", limit: 5, window: 30 * time.Second},
+		{in: "100/1h30m", limit: 100, window: 90 * time.Minute},
+		{in: "20", wantErr: true},
+		{in: "0/1m", wantErr: true},
+		{in: "-1/1m", wantErr: true},
+		{in: "abc/1m", wantErr: true},
+		{in: "20/0s", wantErr: true},
+		{in: "20/-1m", wantErr: true},
+		{in: "20/minute", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			limit, window, err := parseRateLimit(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseRateLimit(%q) = %d, %v, want error", tt.in, limit, window)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRateLimit(%q) error: %v", tt.in, err)
+			}
+			if limit != tt.limit || window != tt.window {
+				t.Errorf("parseRateLimit(%q) = %d, %v, want %d, %v", tt.in, limit, window, tt.limit, tt.window)
+			}
+		})
+	}
+}
+
+func TestLoadRateLimitPolicy(t *testing.T) {
+	base := RateLimitPolicy{Name: "test", Limit: 10, Window: time.Minute}
+
+	tests := []struct {
+		name   string
+		env    string
+		limit  int
+		window time.Duration
+	}{
+		{name: "unset", env: "", limit: 10, window: time.Minute},
+		{name: "override", env: "3/10s", limit: 3, window: 10 * time.Second},
+		{name: "off", env: "OFF", limit: 0, window: time.Minute},
+		{name: "invalid keeps default", env: "lots", limit: 10, window: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RATE_LIMIT_TEST", tt.env)
+			got := loadRateLimitPolicy(base)
+			if got.Limit != tt.limit || got.Window != tt.window {
+				t.Errorf("loadRateLimitPolicy() = %d/%v, want %d/%v", got.Limit, got.Window, tt.limit, tt.window)
+			}
+		})
+	}
+}
+
+func TestMemoryRateLimitStoreTake(t *testing.T) {
+	store := NewMemoryRateLimitStore().(*memoryRateLimitStore)
+	ctx := context.Background()
+
+	// 버킷이 가득 찬 상태로 시작하므로 limit 만큼은 바로 허용
+	for i := range 3 {
+		retryAfter, err := store.Take(ctx, "k", 3, 3*time.Second)
+		if err != nil || retryAfter != 0 {
+			t.Fatalf("take %d = %v, %v, want allowed", i, retryAfter, err)
+		}
+	}
+
+	retryAfter, err := store.Take(ctx, "k", 3, 3*time.Second)
+	if err != nil {
+		t.Fatalf("Take() error: %v", err)
+	}
+	if retryAfter <= 0 || retryAfter > time.Second {
+		t.Fatalf("retryAfter = %v, want (0, 1s]", retryAfter)
+	}
+
+	// 다른 키는 따로 셈
+	if retryAfter, _ := store.Take(ctx, "other", 3, 3*time.Second); retryAfter != 0 {
+		t.Fatalf("other key retryAfter = %v, want allowed", retryAfter)
+	}
+
+	// window/limit 만큼 지나면 토큰 하나가 채워짐
+	store.buckets["k"].updatedAt = store.buckets["k"].updatedAt.Add(-time.Second)
+	if retryAfter, _ := store.Take(ctx, "k", 3, 3*time.Second); retryAfter != 0 {
+		t.Fatalf("after refill retryAfter = %v, want allowed", retryAfter)
+	}
+	if retryAfter, _ := store.Take(ctx, "k", 3, 3*time.Second); retryAfter == 0 {
+		t.Fatal("second take after one refill was allowed")
+	}
+
+	// 오래 지나도 limit 을 넘게 쌓이지 않음
+	store.buckets["k"].updatedAt = time.Now().Add(-time.Hour)
+	for i := range 3 {
+		if retryAfter, _ := store.Take(ctx, "k", 3, 3*time.Second); retryAfter != 0 {
+			t.Fatalf("take %d after long idle = %v, want allowed", i, retryAfter)
+		}
+	}
+	if retryAfter, _ := store.Take(ctx, "k", 3, 3*time.Second); retryAfter == 0 {
+		t.Fatal("bucket refilled past its limit")
+	}
+}
+
+func TestMemoryRateLimitStoreSweep(t *testing.T) {
+	store := NewMemoryRateLimitStore().(*memoryRateLimitStore)
+	ctx := context.Background()
+
+	_, _ = store.Take(ctx, "idle", 1, time.Minute)
+	_, _ = store.Take(ctx, "active", 1, time.Hour)
+	store.buckets["idle"].updatedAt = time.Now().Add(-2 * time.Minute)
+	store.buckets["active"].updatedAt = time.Now().Add(-2 * time.Minute)
+	store.lastSweep = time.Now().Add(-2 * rateLimitSweepInterval)
+
+	_, _ = store.Take(ctx, "new", 1, time.Minute)
+
+	if _, ok := store.buckets["idle"]; ok {
+		t.Error("bucket idle for a full window was not swept")
+	}
+	if _, ok := store.buckets["active"]; !ok {
+		t.Error("bucket still refilling was swept")
+	}
+}
+
+func TestIPExtractor(t *testing.T) {
+	tests := []struct {
+		name    string
+		trusted string
+		remote  string
+		want    string
+	}{
+		{name: "direct ignores header", trusted: "", remote: "203.0.113.7:1234", want: "203.0.113.7"},
+		{name: "direct ignores header from private net", trusted: "", remote: "10.0.0.5:1234", want: "10.0.0.5"},
+		{name: "trusted proxy", trusted: "10.0.0.0/8", remote: "10.0.0.5:1234", want: "198.51.100.1"},
+		{name: "untrusted proxy", trusted: "10.0.0.0/8", remote: "203.0.113.7:1234", want: "203.0.113.7"},
+		{name: "invalid cidr falls back to direct", trusted: "not-a-cidr", remote: "10.0.0.5:1234", want: "10.0.0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TRUSTED_PROXIES", tt.trusted)
+
+			e := echo.New()
+			e.IPExtractor = IPExtractor()
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+			req.RemoteAddr = tt.remote
+			req.Header.Set(echo.HeaderXForwardedFor, "198.51.100.1")
+			req.Header.Set(echo.HeaderXRealIP, "198.51.100.2")
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			if got := KeyByIP(c); got != "ip:"+tt.want {
+				t.Errorf("KeyByIP() = %q, want %q", got, "ip:"+tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Circle 은 친구 중 일부를 묶은 목록이다. 공개 범위 설정에서 서클 단위로 공개 대상을 고른다.
+type Circle struct {
+	ID             primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	UserID         primitive.ObjectID   `bson:"user_id" json:"userId"` // 서클을 만든 유저
+	Name           string               `bson:"name" json:"name"`
+	IsCloseFriends bool                 `bson:"is_close_friends" json:"isCloseFriends"` // 유저마다 하나씩 자동으로 만들어지며 삭제 불가
+	MemberIDs      []primitive.ObjectID `bson:"member_ids" json:"memberIds"`
+	CreatedAt      time.Time            `bson:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time            `bson:"updated_at" json:"updatedAt"`
+}
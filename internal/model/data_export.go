@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DataExportStatus string
+
+const (
+	DataExportPending DataExportStatus = "PENDING"
+	DataExportReady   DataExportStatus = "READY"
+	DataExportFailed  DataExportStatus = "FAILED"
+)
+
+// DataExport 는 개인 데이터 내보내기 작업이다. 완성된 ZIP 은 비공개 저장소의 ObjectKey 에 있다.
+type DataExport struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID      primitive.ObjectID `bson:"user_id" json:"userId"`
+	Status      DataExportStatus   `bson:"status" json:"status"`
+	ObjectKey   string             `bson:"object_key,omitempty" json:"-"`
+	SizeBytes   int64              `bson:"size_bytes,omitempty" json:"sizeBytes"`
+	ClaimedAt   *time.Time         `bson:"claimed_at,omitempty" json:"-"` // 작업을 맡은 인스턴스가 빌드를 시작한 시각
+	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
+	CompletedAt *time.Time         `bson:"completed_at,omitempty" json:"completedAt"`
+	ExpiresAt   *time.Time         `bson:"expires_at,omitempty" json:"expiresAt"` // 이후 파일 삭제
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type FeedEventType string
+
+const (
+	FeedSessionEnded    FeedEventType = "SESSION_ENDED"
+	FeedStreakMilestone FeedEventType = "STREAK_MILESTONE" // 연속 공백 일수가 기념할 만한 수에 도달
+	FeedPersonalRecord  FeedEventType = "PERSONAL_RECORD"  // 가장 긴 공백 기록 경신
+)
+
+// FeedEvent 는 친구 피드에 보여줄 유저의 활동이다. 공개 범위는 조회할 때 적용한다.
+type FeedEvent struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID      primitive.ObjectID `bson:"user_id" json:"userId"`
+	Type        FeedEventType      `bson:"type" json:"type"`
+	SessionID   primitive.ObjectID `bson:"session_id" json:"sessionId"`
+	TargetDay   string             `bson:"target_day" json:"targetDay"`
+	DurationSec int64              `bson:"duration_sec,omitempty" json:"durationSec,omitempty"` // SESSION_ENDED, PERSONAL_RECORD
+	Activities  []string           `bson:"activities,omitempty" json:"activities,omitempty"`    // SESSION_ENDED
+	StreakDays  int                `bson:"streak_days,omitempty" json:"streakDays,omitempty"`   // STREAK_MILESTONE
+	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
+}
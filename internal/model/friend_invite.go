@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type FriendInvite struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Code      string             `bson:"code" json:"code"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"userId"` // 초대한 유저
+	MaxUses   int                `bson:"max_uses" json:"maxUses"`
+	Uses      int                `bson:"uses" json:"uses"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expiresAt"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+}
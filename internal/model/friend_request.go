@@ -12,13 +12,16 @@ const (
 	FriendRequestPending  FriendRequestStatus = "PENDING"
 	FriendRequestAccepted FriendRequestStatus = "ACCEPTED"
 	FriendRequestRejected FriendRequestStatus = "REJECTED"
+	FriendRequestExpired  FriendRequestStatus = "EXPIRED" // 오래 응답이 없어 만료됨
 )
 
 type FriendRequest struct {
-	ID         primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
-	SenderID   primitive.ObjectID  `bson:"sender_id" json:"senderId"`
-	ReceiverID primitive.ObjectID  `bson:"receiver_id" json:"receiverId"`
-	Status     FriendRequestStatus `bson:"status" json:"status"`
-	CreatedAt  time.Time           `bson:"created_at" json:"createdAt"`
-	UpdatedAt  time.Time           `bson:"updated_at" json:"updatedAt"`
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	SenderID     primitive.ObjectID  `bson:"sender_id" json:"senderId"`
+	ReceiverID   primitive.ObjectID  `bson:"receiver_id" json:"receiverId"`
+	Status       FriendRequestStatus `bson:"status" json:"status"`
+	CreatedAt    time.Time           `bson:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time           `bson:"updated_at" json:"updatedAt"`
+	RejectedAt   *time.Time          `bson:"rejected_at,omitempty" json:"-"`   // 보낸 사람에게는 알리지 않음. 만료된 뒤에도 재요청 제한 계산에 사용
+	SenderHidden bool                `bson:"sender_hidden,omitempty" json:"-"` // 거절된 요청을 보낸 사람이 취소한 경우
 }
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Identity 는 유저에 연결된 소셜 계정이다. 유저 한 명에 제공자별로 하나씩 연결할 수 있다.
+type Identity struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID            primitive.ObjectID `bson:"user_id" json:"userId"`
+	Provider          SocialProvider     `bson:"provider" json:"provider"`
+	SocialID          string             `bson:"social_id" json:"socialId"`
+	AppleRefreshToken string             `bson:"apple_refresh_token,omitempty" json:"-"` // 연결 해제·탈퇴 시 revoke 용
+	CreatedAt         time.Time          `bson:"created_at" json:"createdAt"`
+	LastUsedAt        *time.Time         `bson:"last_used_at,omitempty" json:"lastUsedAt"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type NicknameHistory struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID      primitive.ObjectID `bson:"user_id" json:"userId"`
+	OldNickname string             `bson:"old_nickname" json:"oldNickname"`
+	NewNickname string             `bson:"new_nickname" json:"newNickname"`
+	ChangedAt   time.Time          `bson:"changed_at" json:"changedAt"`
+}
@@ -9,27 +9,32 @@ import (
 type NotificationType string
 
 const (
-	NotifVoidReminder  NotificationType = "VOID_REMINDER"
-	NotifFriendRequest NotificationType = "FRIEND_REQUEST"
-	NotifFriendAccept  NotificationType = "FRIEND_ACCEPT"
-	NotifFriendNudge   NotificationType = "FRIEND_NUDGE"
+	NotifVoidReminder   NotificationType = "VOID_REMINDER"
+	NotifFriendRequest  NotificationType = "FRIEND_REQUEST"
+	NotifFriendAccept   NotificationType = "FRIEND_ACCEPT"
+	NotifFriendNudge    NotificationType = "FRIEND_NUDGE"
+	NotifFriendInvite   NotificationType = "FRIEND_INVITE"
+	NotifFriendExpired  NotificationType = "FRIEND_REQUEST_EXPIRED"
+	NotifSessionReact   NotificationType = "SESSION_REACTION"
+	NotifVoidRoomInvite NotificationType = "VOID_ROOM_INVITE"
+	NotifVoidRoomJoin   NotificationType = "VOID_ROOM_JOIN"
 )
 
 type Notification struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	UserID    primitive.ObjectID `bson:"user_id"`
-	Type      NotificationType   `bson:"type"`
-	Title     string             `bson:"title"`
-	Body      string             `bson:"body"`
-	Data      map[string]string  `bson:"data,omitempty"`
-	IsRead    bool               `bson:"is_read"`
-	CreatedAt time.Time          `bson:"created_at"`
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"userId"`
+	Type      NotificationType   `bson:"type" json:"type"`
+	Title     string             `bson:"title" json:"title"`
+	Body      string             `bson:"body" json:"body"`
+	Data      map[string]string  `bson:"data,omitempty" json:"data,omitempty"`
+	IsRead    bool               `bson:"is_read" json:"isRead"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
 }
 
 type DeviceToken struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	UserID    primitive.ObjectID `bson:"user_id"`
-	Token     string             `bson:"token"`
-	CreatedAt time.Time          `bson:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at"`
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"userId"`
+	Token     string             `bson:"token" json:"token"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updatedAt"`
 }
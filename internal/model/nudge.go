@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Nudge struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	SenderID   primitive.ObjectID `bson:"sender_id" json:"senderId"`
+	ReceiverID primitive.ObjectID `bson:"receiver_id" json:"receiverId"`
+	TargetDay  string             `bson:"target_day" json:"targetDay"` // 받은 사람 기준 "오늘 밤" 조회용
+	CreatedAt  time.Time          `bson:"created_at" json:"createdAt"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RefreshToken 은 해시로만 저장한다. 같은 로그인에서 회전된 토큰은 FamilyID 를 공유한다.
+type RefreshToken struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"userId"`
+	FamilyID  primitive.ObjectID `bson:"family_id" json:"familyId"`
+	TokenHash string             `bson:"token_hash" json:"-"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expiresAt"`
+	UsedAt    *time.Time         `bson:"used_at,omitempty" json:"usedAt"`
+	RevokedAt *time.Time         `bson:"revoked_at,omitempty" json:"revokedAt"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Session 은 로그인 한 번에 해당한다. 액세스 토큰의 sid, refresh token 의 FamilyID 가 세션 ID 이다.
+type Session struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID     primitive.ObjectID `bson:"user_id" json:"userId"`
+	Provider   SocialProvider     `bson:"provider" json:"provider"`
+	DeviceName string             `bson:"device_name,omitempty" json:"deviceName"`
+	Platform   string             `bson:"platform,omitempty" json:"platform"`
+	AppVersion string             `bson:"app_version,omitempty" json:"appVersion"`
+	UserAgent  string             `bson:"user_agent,omitempty" json:"userAgent"`
+	IP         string             `bson:"ip,omitempty" json:"ip"`
+	CreatedAt  time.Time          `bson:"created_at" json:"createdAt"`
+	LastSeenAt time.Time          `bson:"last_seen_at" json:"lastSeenAt"` // 마지막 토큰 갱신 시각
+	RevokedAt  *time.Time         `bson:"revoked_at,omitempty" json:"revokedAt"`
+}
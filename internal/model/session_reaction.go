@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReactionEmoji string
+
+// 친구의 공백 기록에 남길 수 있는 반응. 클라이언트가 코드를 이모지로 표시한다.
+const (
+	ReactionClap   ReactionEmoji = "CLAP"   // 👏
+	ReactionFire   ReactionEmoji = "FIRE"   // 🔥
+	ReactionHeart  ReactionEmoji = "HEART"  // ❤️
+	ReactionMoon   ReactionEmoji = "MOON"   // 🌙
+	ReactionCoffee ReactionEmoji = "COFFEE" // ☕
+)
+
+// SessionReaction 은 친구가 끝난 공백에 남긴 반응이다. 친구마다 세션당 하나.
+type SessionReaction struct {
+	ID            primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	SessionID     primitive.ObjectID  `bson:"session_id" json:"sessionId"`
+	OwnerID       primitive.ObjectID  `bson:"owner_id" json:"ownerId"` // 세션 주인
+	UserID        primitive.ObjectID  `bson:"user_id" json:"userId"`   // 반응한 유저
+	Emoji         ReactionEmoji       `bson:"emoji" json:"emoji"`
+	Notified      bool                `bson:"notified" json:"-"`                  // 세션 주인에게 알림을 보냈는지
+	NotifyBatchID *primitive.ObjectID `bson:"notify_batch_id,omitempty" json:"-"` // 알림을 맡은 묶음. 여러 인스턴스가 같은 반응을 알리지 않도록 함
+	CreatedAt     time.Time           `bson:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time           `bson:"updated_at" json:"updatedAt"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SuggestionDismissal 은 친구 추천에서 다시 보지 않기로 한 유저다.
+type SuggestionDismissal struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID      primitive.ObjectID `bson:"user_id" json:"userId"`
+	DismissedID primitive.ObjectID `bson:"dismissed_id" json:"dismissedId"`
+	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
+}
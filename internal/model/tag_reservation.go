@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 태그 변경 후 이전 태그를 일정 기간 원래 유저에게 묶어두기 위한 예약
+type TagReservation struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Tag       string             `bson:"tag" json:"tag"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"userId"`
+	ExpiresAt time.Time          `bson:"expires_at" json:"expiresAt"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+}
@@ -22,17 +22,36 @@ type NotificationSettings struct {
 	FriendNudge   bool `bson:"friend_nudge"    json:"friendNudge"`
 }
 
+type StatsVisibility string
+
+const (
+	StatsVisibilityEveryone StatsVisibility = "EVERYONE"
+	StatsVisibilityFriends  StatsVisibility = "FRIENDS"
+	StatsVisibilityNobody   StatsVisibility = "NOBODY"
+)
+
+// 서클 목록이 비어 있으면 모든 친구에게 공개하고, 지정하면 그 서클에 속한 친구에게만 공개한다.
+type PrivacySettings struct {
+	StatsVisibility StatsVisibility      `bson:"stats_visibility" json:"statsVisibility"`           // 비어 있으면 FRIENDS
+	StatsCircles    []primitive.ObjectID `bson:"stats_circles,omitempty" json:"statsCircles"`       // StatsVisibility 가 FRIENDS 일 때만 적용
+	PresenceCircles []primitive.ObjectID `bson:"presence_circles,omitempty" json:"presenceCircles"` // 공백 중 여부와 시작·종료 시각
+	SessionCircles  []primitive.ObjectID `bson:"session_circles,omitempty" json:"sessionCircles"`   // 친구 피드의 공백 기록
+	ShareActivities bool                 `bson:"share_activities,omitempty" json:"shareActivities"` // 친구 피드의 공백 기록에 활동을 함께 공개
+}
+
 type User struct {
 	ID                   primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
-	SocialProvider       SocialProvider       `bson:"social_provider" json:"socialProvider"`
-	SocialID             string               `bson:"social_id" json:"socialId"`
 	Nickname             string               `bson:"nickname" json:"nickname,omitempty"`
 	Tag                  string               `bson:"tag" json:"tag"`
+	TagChangedAt         *time.Time           `bson:"tag_changed_at,omitempty" json:"tagChangedAt"`
 	IsInVoid             bool                 `bson:"is_in_void" json:"isInVoid"`
 	CurrentVoidStartedAt *time.Time           `bson:"current_void_started_at,omitempty" json:"currentVoidStartedAt"`
 	LastVoidEndedAt      *time.Time           `bson:"last_void_ended_at,omitempty" json:"lastVoidEndedAt"`
 	NotificationSettings NotificationSettings `bson:"notification_settings" json:"notificationSettings"`
-	AppleRefreshToken    string               `bson:"apple_refresh_token,omitempty" json:"-"`
+	PrivacySettings      PrivacySettings      `bson:"privacy_settings" json:"privacySettings"`
+	AvatarKey            string               `bson:"avatar_key,omitempty" json:"-"`                              // 저장소 키 접두사 (크기별 파일은 <key>_<size>.jpg)
+	SuspendedAt          *time.Time           `bson:"suspended_at,omitempty" json:"suspendedAt"`                  // 정지된 계정은 로그인과 모든 토큰 사용 불가
+	DeletionRequestedAt  *time.Time           `bson:"deletion_requested_at,omitempty" json:"deletionRequestedAt"` // 탈퇴 유예 중. 조회에서 제외되며 유예 기간 안에 로그인하면 복구
 	CreatedAt            time.Time            `bson:"created_at" json:"createdAt"`
 	UpdatedAt            time.Time            `bson:"updated_at" json:"updatedAt"`
 }
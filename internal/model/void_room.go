@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type VoidRoomStatus string
+
+const (
+	VoidRoomOpen   VoidRoomStatus = "OPEN"
+	VoidRoomClosed VoidRoomStatus = "CLOSED"
+)
+
+// VoidRoom 은 친구들과 함께 공백하는 방이다. 멤버로 있는 동안 끝낸 공백은 방에 연결된다.
+// 마지막 멤버가 나가거나 한동안 아무 활동이 없으면 닫히고, 그때까지 연결된 공백의 합계를 남긴다.
+type VoidRoom struct {
+	ID               primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	OwnerID          primitive.ObjectID   `bson:"owner_id" json:"ownerId"` // 방을 만든 유저
+	Status           VoidRoomStatus       `bson:"status" json:"status"`
+	MemberIDs        []primitive.ObjectID `bson:"member_ids" json:"memberIds"`           // 지금 방에 있는 유저
+	ParticipantIDs   []primitive.ObjectID `bson:"participant_ids" json:"participantIds"` // 한 번이라도 들어온 유저
+	InvitedIDs       []primitive.ObjectID `bson:"invited_ids" json:"invitedIds"`
+	TotalDurationSec int64                `bson:"total_duration_sec" json:"totalDurationSec"` // 닫힐 때 기록
+	SessionCount     int                  `bson:"session_count" json:"sessionCount"`          // 닫힐 때 기록
+	ActiveAt         time.Time            `bson:"active_at" json:"activeAt"`                  // 마지막으로 멤버가 들어오거나 나가거나 공백을 시작·종료한 시각
+	CreatedAt        time.Time            `bson:"created_at" json:"createdAt"`
+	ClosedAt         *time.Time           `bson:"closed_at,omitempty" json:"closedAt,omitempty"`
+}
+
+// VoidRoomDuration 은 방에 연결된 유저별 공백 합계다.
+type VoidRoomDuration struct {
+	UserID           primitive.ObjectID `bson:"_id"`
+	TotalDurationSec int64              `bson:"total_duration_sec"`
+	SessionCount     int                `bson:"session_count"`
+}
@@ -7,14 +7,16 @@ import (
 )
 
 type VoidSession struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	UserID      primitive.ObjectID `bson:"user_id" json:"userId"`
-	StartedAt   time.Time          `bson:"started_at" json:"startedAt"`
-	EndedAt     time.Time          `bson:"ended_at" json:"endedAt"`
-	DurationSec int64              `bson:"duration_sec" json:"durationSec"`
-	TargetDay   string             `bson:"target_day" json:"targetDay"`
-	Activities  []string           `bson:"activities" json:"activities"`
-	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
+	ID          primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	UserID      primitive.ObjectID  `bson:"user_id" json:"userId"`
+	StartedAt   time.Time           `bson:"started_at" json:"startedAt"`
+	EndedAt     time.Time           `bson:"ended_at" json:"endedAt"`
+	DurationSec int64               `bson:"duration_sec" json:"durationSec"`
+	TargetDay   string              `bson:"target_day" json:"targetDay"`
+	Activities  []string            `bson:"activities" json:"activities"`
+	RoomID      *primitive.ObjectID `bson:"room_id,omitempty" json:"roomId,omitempty"` // 함께 공백한 방
+	Hidden      bool                `bson:"hidden,omitempty" json:"-"`                 // 탈퇴 유예 중인 유저의 세션. 전체 통계에서 제외
+	CreatedAt   time.Time           `bson:"created_at" json:"createdAt"`
 }
 
 type VoidUserStats struct {
@@ -22,3 +24,8 @@ type VoidUserStats struct {
 	SessionCount     int   `bson:"session_count"`
 	MaxDurationSec   int64 `bson:"max_duration_sec"`
 }
+
+type VoidDailyDuration struct {
+	TargetDay        string `bson:"_id"`
+	TotalDurationSec int64  `bson:"total_duration_sec"`
+}
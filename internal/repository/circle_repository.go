@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CircleRepository interface {
+	Create(ctx context.Context, circle *model.Circle) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Circle, error)
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Circle, error)
+	FindByOwnersContainingMember(ctx context.Context, ownerIDs []primitive.ObjectID, memberID primitive.ObjectID) ([]model.Circle, error)
+	CountByUserID(ctx context.Context, userID primitive.ObjectID) (int64, error)
+	EnsureCloseFriends(ctx context.Context, userID primitive.ObjectID, name string) error
+	UpdateName(ctx context.Context, id primitive.ObjectID, name string) error
+	SetMembers(ctx context.Context, id primitive.ObjectID, memberIDs []primitive.ObjectID) error
+	RemoveMember(ctx context.Context, ownerID, memberID primitive.ObjectID) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type circleRepository struct {
+	coll *mongo.Collection
+}
+
+func NewCircleRepository(db *mongo.Database) CircleRepository {
+	return &circleRepository{coll: db.Collection("circles")}
+}
+
+func (r *circleRepository) Create(ctx context.Context, circle *model.Circle) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, circle)
+	if err != nil {
+		return err
+	}
+	circle.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *circleRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Circle, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var circle model.Circle
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&circle)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &circle, err
+}
+
+// FindByUserID 는 유저가 만든 서클을 친한 친구 서클, 만든 순서대로 반환한다.
+func (r *circleRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Circle, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "is_close_friends", Value: -1},
+		{Key: "created_at", Value: 1},
+	})
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var circles []model.Circle
+	if err := cursor.All(ctx, &circles); err != nil {
+		return nil, err
+	}
+	return circles, nil
+}
+
+// FindByOwnersContainingMember 는 ownerIDs 가 만든 서클 중 memberID 가 속한 서클을 반환한다.
+func (r *circleRepository) FindByOwnersContainingMember(ctx context.Context, ownerIDs []primitive.ObjectID, memberID primitive.ObjectID) ([]model.Circle, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.coll.Find(ctx, bson.M{
+		"user_id":    bson.M{"$in": ownerIDs},
+		"member_ids": memberID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var circles []model.Circle
+	if err := cursor.All(ctx, &circles); err != nil {
+		return nil, err
+	}
+	return circles, nil
+}
+
+func (r *circleRepository) CountByUserID(ctx context.Context, userID primitive.ObjectID) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return r.coll.CountDocuments(ctx, bson.M{"user_id": userID})
+}
+
+// EnsureCloseFriends 는 친한 친구 서클이 없으면 만든다.
+func (r *circleRepository) EnsureCloseFriends(ctx context.Context, userID primitive.ObjectID, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	opts := options.Update().SetUpsert(true)
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"user_id": userID, "is_close_friends": true},
+		bson.M{"$setOnInsert": bson.M{
+			"name":       name,
+			"member_ids": bson.A{},
+			"created_at": now,
+			"updated_at": now,
+		}},
+		opts,
+	)
+	return err
+}
+
+func (r *circleRepository) UpdateName(ctx context.Context, id primitive.ObjectID, name string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{"name": name, "updated_at": time.Now()},
+	})
+	return err
+}
+
+func (r *circleRepository) SetMembers(ctx context.Context, id primitive.ObjectID, memberIDs []primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{"member_ids": memberIDs, "updated_at": time.Now()},
+	})
+	return err
+}
+
+// RemoveMember 는 ownerID 가 만든 모든 서클에서 memberID 를 뺀다.
+func (r *circleRepository) RemoveMember(ctx context.Context, ownerID, memberID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateMany(ctx,
+		bson.M{"user_id": ownerID, "member_ids": memberID},
+		bson.M{
+			"$pull": bson.M{"member_ids": memberID},
+			"$set":  bson.M{"updated_at": time.Now()},
+		},
+	)
+	return err
+}
+
+func (r *circleRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+// DeleteByUserID 는 유저가 만든 서클을 지우고 다른 유저의 서클에서도 뺀다.
+func (r *circleRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID}); err != nil {
+		return err
+	}
+	_, err := r.coll.UpdateMany(ctx,
+		bson.M{"member_ids": userID},
+		bson.M{"$pull": bson.M{"member_ids": userID}},
+	)
+	return err
+}
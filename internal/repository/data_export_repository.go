@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DataExportRepository interface {
+	Create(ctx context.Context, export *model.DataExport) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.DataExport, error)
+	FindLatestByUserID(ctx context.Context, userID primitive.ObjectID) (*model.DataExport, error)
+	FindPending(ctx context.Context) ([]model.DataExport, error)
+	Claim(ctx context.Context, id primitive.ObjectID, now time.Time, staleBefore time.Time) (bool, error)
+	FindExpired(ctx context.Context, now time.Time) ([]model.DataExport, error)
+	MarkReady(ctx context.Context, id primitive.ObjectID, objectKey string, size int64, completedAt time.Time, expiresAt time.Time) error
+	MarkFailed(ctx context.Context, id primitive.ObjectID, completedAt time.Time) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.DataExport, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type dataExportRepository struct {
+	coll *mongo.Collection
+}
+
+func NewDataExportRepository(db *mongo.Database) DataExportRepository {
+	return &dataExportRepository{coll: db.Collection("data_exports")}
+}
+
+// 유저별 PENDING 작업 partial unique index(README 참고)로 동시에 요청해도 하나만 만들어지고, 나머지는 duplicate key 에러를 받는다.
+func (r *dataExportRepository) Create(ctx context.Context, export *model.DataExport) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, export)
+	if err != nil {
+		return err
+	}
+	export.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *dataExportRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.DataExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var export model.DataExport
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&export)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &export, err
+}
+
+func (r *dataExportRepository) FindLatestByUserID(ctx context.Context, userID primitive.ObjectID) (*model.DataExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	var export model.DataExport
+	err := r.coll.FindOne(ctx, bson.M{"user_id": userID}, opts).Decode(&export)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &export, err
+}
+
+// FindPending 은 서버 재시작 등으로 완료되지 않은 작업을 반환한다.
+func (r *dataExportRepository) FindPending(ctx context.Context) ([]model.DataExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.coll.Find(ctx, bson.M{"status": model.DataExportPending})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var exports []model.DataExport
+	if err := cursor.All(ctx, &exports); err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+// Claim 은 아무도 맡지 않았거나 staleBefore 전에 맡고 끝내지 못한 PENDING 작업을 가져온다.
+// 여러 인스턴스가 동시에 시도해도 하나만 true 를 받는다.
+func (r *dataExportRepository) Claim(ctx context.Context, id primitive.ObjectID, now time.Time, staleBefore time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.UpdateOne(ctx,
+		bson.M{
+			"_id":    id,
+			"status": model.DataExportPending,
+			"$or": bson.A{
+				bson.M{"claimed_at": bson.M{"$exists": false}},
+				bson.M{"claimed_at": bson.M{"$lt": staleBefore}},
+			},
+		},
+		bson.M{"$set": bson.M{"claimed_at": now}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+func (r *dataExportRepository) FindExpired(ctx context.Context, now time.Time) ([]model.DataExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.coll.Find(ctx, bson.M{"expires_at": bson.M{"$lte": now}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var exports []model.DataExport
+	if err := cursor.All(ctx, &exports); err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+func (r *dataExportRepository) MarkReady(ctx context.Context, id primitive.ObjectID, objectKey string, size int64, completedAt time.Time, expiresAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{
+		"status":       model.DataExportReady,
+		"object_key":   objectKey,
+		"size_bytes":   size,
+		"completed_at": completedAt,
+		"expires_at":   expiresAt,
+	}})
+	return err
+}
+
+func (r *dataExportRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, completedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{
+		"status":       model.DataExportFailed,
+		"completed_at": completedAt,
+	}})
+	return err
+}
+
+func (r *dataExportRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *dataExportRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.DataExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var exports []model.DataExport
+	if err := cursor.All(ctx, &exports); err != nil {
+		return nil, err
+	}
+	return exports, nil
+}
+
+func (r *dataExportRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FeedQuery 는 조회하는 유저가 볼 수 있는 피드 범위다.
+// 공백 기록은 SessionUserIDs 의 것만, 연속 기록·최고 기록은 StatUserIDs 의 것만 조회한다.
+type FeedQuery struct {
+	SessionUserIDs []primitive.ObjectID
+	StatUserIDs    []primitive.ObjectID
+	BeforeAt       *time.Time         // 이전 페이지 마지막 항목의 created_at
+	BeforeID       primitive.ObjectID // created_at 이 같은 항목은 _id 로 구분
+	Limit          int64
+}
+
+type FeedEventRepository interface {
+	Create(ctx context.Context, event *model.FeedEvent) error
+	Find(ctx context.Context, query FeedQuery) ([]model.FeedEvent, error)
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.FeedEvent, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type feedEventRepository struct {
+	coll *mongo.Collection
+}
+
+func NewFeedEventRepository(db *mongo.Database) FeedEventRepository {
+	return &feedEventRepository{coll: db.Collection("feed_events")}
+}
+
+func (r *feedEventRepository) Create(ctx context.Context, event *model.FeedEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, event)
+	if err != nil {
+		return err
+	}
+	event.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// Find 는 최신순으로 반환한다.
+func (r *feedEventRepository) Find(ctx context.Context, query FeedQuery) ([]model.FeedEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"user_id": bson.M{"$in": query.SessionUserIDs}, "type": model.FeedSessionEnded},
+		bson.M{"user_id": bson.M{"$in": query.StatUserIDs}, "type": bson.M{"$in": bson.A{model.FeedStreakMilestone, model.FeedPersonalRecord}}},
+	}}
+	if query.BeforeAt != nil {
+		filter = bson.M{"$and": bson.A{filter, bson.M{"$or": bson.A{
+			bson.M{"created_at": bson.M{"$lt": *query.BeforeAt}},
+			bson.M{"created_at": *query.BeforeAt, "_id": bson.M{"$lt": query.BeforeID}},
+		}}}}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(query.Limit)
+	cursor, err := r.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []model.FeedEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *feedEventRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.FeedEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var events []model.FeedEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *feedEventRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type FriendInviteRepository interface {
+	Create(ctx context.Context, invite *model.FriendInvite) error
+	FindByCode(ctx context.Context, code string) (*model.FriendInvite, error)
+	Use(ctx context.Context, id primitive.ObjectID, now time.Time) (bool, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type friendInviteRepository struct {
+	coll *mongo.Collection
+}
+
+func NewFriendInviteRepository(db *mongo.Database) FriendInviteRepository {
+	return &friendInviteRepository{coll: db.Collection("friend_invites")}
+}
+
+// Create 는 code 유니크 인덱스(README 의 MongoDB Indexes 참고) 기준으로 중복이면 duplicate key 에러를 반환한다.
+func (r *friendInviteRepository) Create(ctx context.Context, invite *model.FriendInvite) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, invite)
+	if err != nil {
+		return err
+	}
+	invite.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *friendInviteRepository) FindByCode(ctx context.Context, code string) (*model.FriendInvite, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var invite model.FriendInvite
+	err := r.coll.FindOne(ctx, bson.M{"code": code}).Decode(&invite)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &invite, err
+}
+
+// Use 는 만료되지 않았고 사용 횟수가 남은 초대의 사용 횟수를 하나 올린다.
+// 동시에 사용해도 max_uses 를 넘지 않으며, 남은 횟수가 없으면 false 를 반환한다.
+func (r *friendInviteRepository) Use(ctx context.Context, id primitive.ObjectID, now time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.UpdateOne(ctx,
+		bson.M{
+			"_id":        id,
+			"expires_at": bson.M{"$gt": now},
+			"$expr":      bson.M{"$lt": bson.A{"$uses", "$max_uses"}},
+		},
+		bson.M{"$inc": bson.M{"uses": 1}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *friendInviteRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
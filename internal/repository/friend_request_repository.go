@@ -15,10 +15,17 @@ import (
 type FriendRequestRepository interface {
 	FindByID(ctx context.Context, id primitive.ObjectID) (*model.FriendRequest, error)
 	FindPending(ctx context.Context, senderID, receiverID primitive.ObjectID) (*model.FriendRequest, error)
-	FindByReceiverID(ctx context.Context, receiverID primitive.ObjectID, status model.FriendRequestStatus) ([]model.FriendRequest, error)
-	FindBySenderID(ctx context.Context, senderID primitive.ObjectID) ([]model.FriendRequest, error)
+	FindByReceiverID(ctx context.Context, receiverID primitive.ObjectID, statuses []model.FriendRequestStatus) ([]model.FriendRequest, error)
+	FindBySenderID(ctx context.Context, senderID primitive.ObjectID, statuses []model.FriendRequestStatus) ([]model.FriendRequest, error)
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.FriendRequest, error)
+	FindLatestRejected(ctx context.Context, senderID, receiverID primitive.ObjectID) (*model.FriendRequest, error)
+	FindOpenCreatedBefore(ctx context.Context, before time.Time, limit int64) ([]model.FriendRequest, error)
 	Create(ctx context.Context, req *model.FriendRequest) error
 	UpdateStatus(ctx context.Context, id primitive.ObjectID, status model.FriendRequestStatus) error
+	Reject(ctx context.Context, id primitive.ObjectID) error
+	HideFromSender(ctx context.Context, id primitive.ObjectID) error
+	ExpireIfOpen(ctx context.Context, id primitive.ObjectID) (bool, error)
+	DeleteExpiredBefore(ctx context.Context, before time.Time, rejectedBefore time.Time) (int64, error)
 	DeleteByID(ctx context.Context, id primitive.ObjectID) error
 	DeleteByUserPair(ctx context.Context, userA, userB primitive.ObjectID) error
 	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
@@ -60,14 +67,14 @@ func (r *friendRequestRepository) FindPending(ctx context.Context, senderID, rec
 	return &req, err
 }
 
-func (r *friendRequestRepository) FindByReceiverID(ctx context.Context, receiverID primitive.ObjectID, status model.FriendRequestStatus) ([]model.FriendRequest, error) {
+func (r *friendRequestRepository) FindByReceiverID(ctx context.Context, receiverID primitive.ObjectID, statuses []model.FriendRequestStatus) ([]model.FriendRequest, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
 	cursor, err := r.coll.Find(ctx, bson.M{
 		"receiver_id": receiverID,
-		"status":      status,
+		"status":      bson.M{"$in": statuses},
 	}, opts)
 	if err != nil {
 		return nil, err
@@ -81,21 +88,81 @@ func (r *friendRequestRepository) FindByReceiverID(ctx context.Context, receiver
 	return requests, nil
 }
 
-func (r *friendRequestRepository) FindBySenderID(ctx context.Context, senderID primitive.ObjectID) ([]model.FriendRequest, error) {
+func (r *friendRequestRepository) FindBySenderID(ctx context.Context, senderID primitive.ObjectID, statuses []model.FriendRequestStatus) ([]model.FriendRequest, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	sevenDaysAgo := time.Now().AddDate(0, 0, -7)
-	filter := bson.M{
-		"sender_id": senderID,
-		"$or": bson.A{
-			bson.M{"status": model.FriendRequestPending},
-			bson.M{"status": model.FriendRequestRejected, "updated_at": bson.M{"$gte": sevenDaysAgo}},
-		},
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.coll.Find(ctx, bson.M{
+		"sender_id":     senderID,
+		"status":        bson.M{"$in": statuses},
+		"sender_hidden": bson.M{"$ne": true},
+	}, opts)
+	if err != nil {
+		return nil, err
 	}
+	defer cursor.Close(ctx)
 
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
-	cursor, err := r.coll.Find(ctx, filter, opts)
+	var requests []model.FriendRequest
+	if err := cursor.All(ctx, &requests); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// FindByUserID 는 유저가 보내거나 받은 모든 요청을 상태와 관계없이 반환한다.
+func (r *friendRequestRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.FriendRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.coll.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"sender_id": userID},
+		bson.M{"receiver_id": userID},
+	}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var requests []model.FriendRequest
+	if err := cursor.All(ctx, &requests); err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+// FindLatestRejected 는 sender 가 receiver 에게 보냈다가 거절된 마지막 요청을 만료 여부와 관계없이 반환한다.
+func (r *friendRequestRepository) FindLatestRejected(ctx context.Context, senderID, receiverID primitive.ObjectID) (*model.FriendRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "rejected_at", Value: -1}})
+	var req model.FriendRequest
+	err := r.coll.FindOne(ctx, bson.M{
+		"sender_id":   senderID,
+		"receiver_id": receiverID,
+		"rejected_at": bson.M{"$exists": true},
+	}, opts).Decode(&req)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &req, err
+}
+
+// FindOpenCreatedBefore 는 before 이전에 보낸 뒤 아직 만료되지 않은 대기·거절 요청을 오래된 순으로 반환한다.
+// 보낸 사람에게 거절은 대기 중으로 보이므로 두 상태를 함께 만료시킨다.
+func (r *friendRequestRepository) FindOpenCreatedBefore(ctx context.Context, before time.Time, limit int64) ([]model.FriendRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(limit)
+	cursor, err := r.coll.Find(ctx, bson.M{
+		"status":     bson.M{"$in": bson.A{model.FriendRequestPending, model.FriendRequestRejected}},
+		"created_at": bson.M{"$lt": before},
+	}, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +197,62 @@ func (r *friendRequestRepository) UpdateStatus(ctx context.Context, id primitive
 	return err
 }
 
+func (r *friendRequestRepository) Reject(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{"status": model.FriendRequestRejected, "rejected_at": now, "updated_at": now},
+	})
+	return err
+}
+
+func (r *friendRequestRepository) HideFromSender(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{"sender_hidden": true, "updated_at": time.Now()},
+	})
+	return err
+}
+
+// ExpireIfOpen 은 아직 대기·거절 상태인 요청만 만료 처리한다. 그 사이 수락·삭제됐으면 false 를 반환한다.
+func (r *friendRequestRepository) ExpireIfOpen(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": bson.M{"$in": bson.A{model.FriendRequestPending, model.FriendRequestRejected}}},
+		bson.M{"$set": bson.M{"status": model.FriendRequestExpired, "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount == 1, nil
+}
+
+// DeleteExpiredBefore 는 before 이전에 만료된 요청을 지운다.
+// 거절된 적이 있는 요청은 재요청 제한이 끝날 때까지(rejectedBefore 이전에 거절된 것만) 남겨 둔다.
+func (r *friendRequestRepository) DeleteExpiredBefore(ctx context.Context, before time.Time, rejectedBefore time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.DeleteMany(ctx, bson.M{
+		"status":     model.FriendRequestExpired,
+		"updated_at": bson.M{"$lt": before},
+		"$or": bson.A{
+			bson.M{"rejected_at": bson.M{"$exists": false}},
+			bson.M{"rejected_at": bson.M{"$lt": rejectedBefore}},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
 func (r *friendRequestRepository) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
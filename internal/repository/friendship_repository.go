@@ -12,8 +12,16 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// MutualFriendCount 는 친구의 친구 한 명과, 그 유저와 함께 아는 친구 수다.
+type MutualFriendCount struct {
+	UserID    primitive.ObjectID   `bson:"_id"`
+	Count     int                  `bson:"count"`
+	MutualIDs []primitive.ObjectID `bson:"mutual_ids"` // 함께 아는 친구 중 일부
+}
+
 type FriendshipRepository interface {
 	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Friendship, error)
+	CountMutualFriends(ctx context.Context, friendIDs []primitive.ObjectID, excludeIDs []primitive.ObjectID, mutualLimit int, limit int) ([]MutualFriendCount, error)
 	FindOne(ctx context.Context, userID, friendID primitive.ObjectID) (*model.Friendship, error)
 	Create(ctx context.Context, friendship *model.Friendship) error
 	DeleteByUserPair(ctx context.Context, userA, userB primitive.ObjectID) error
@@ -46,6 +54,43 @@ func (r *friendshipRepository) FindByUserID(ctx context.Context, userID primitiv
 	return friendships, nil
 }
 
+// CountMutualFriends 는 friendIDs 의 친구를 함께 아는 친구 수가 많은 순으로 반환한다.
+// excludeIDs 에 있는 유저는 빼고, 함께 아는 친구는 mutualLimit 명까지만 담는다.
+func (r *friendshipRepository) CountMutualFriends(ctx context.Context, friendIDs []primitive.ObjectID, excludeIDs []primitive.ObjectID, mutualLimit int, limit int) ([]MutualFriendCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user_id":   bson.M{"$in": friendIDs},
+			"friend_id": bson.M{"$nin": excludeIDs},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":        "$friend_id",
+			"count":      bson.M{"$sum": 1},
+			"mutual_ids": bson.M{"$push": "$user_id"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"count":      1,
+			"mutual_ids": bson.M{"$slice": bson.A{"$mutual_ids", mutualLimit}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []MutualFriendCount
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 func (r *friendshipRepository) FindOne(ctx context.Context, userID, friendID primitive.ObjectID) (*model.Friendship, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
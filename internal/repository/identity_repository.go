@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type IdentityRepository interface {
+	Create(ctx context.Context, identity *model.Identity) error
+	FindBySocial(ctx context.Context, provider model.SocialProvider, socialID string) (*model.Identity, error)
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Identity, error)
+	Touch(ctx context.Context, id primitive.ObjectID, usedAt time.Time, appleRefreshToken string) error
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type identityRepository struct {
+	coll *mongo.Collection
+}
+
+func NewIdentityRepository(db *mongo.Database) IdentityRepository {
+	return &identityRepository{coll: db.Collection("identities")}
+}
+
+// (provider, social_id) 와 (user_id, provider) 유니크 인덱스(README 의 MongoDB Indexes 참고) 기준으로 중복이면 duplicate key 에러를 반환한다.
+func (r *identityRepository) Create(ctx context.Context, identity *model.Identity) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, identity)
+	if err != nil {
+		return err
+	}
+	identity.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *identityRepository) FindBySocial(ctx context.Context, provider model.SocialProvider, socialID string) (*model.Identity, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var identity model.Identity
+	err := r.coll.FindOne(ctx, bson.M{
+		"provider":  provider,
+		"social_id": socialID,
+	}).Decode(&identity)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &identity, err
+}
+
+// FindByUserID 는 유저에 연결된 소셜 계정을 연결한 순서대로 반환한다.
+func (r *identityRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Identity, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var identities []model.Identity
+	if err := cursor.All(ctx, &identities); err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+// Touch 는 마지막 로그인 시각을 갱신한다. appleRefreshToken 이 비어 있으면 기존 값을 유지한다.
+func (r *identityRepository) Touch(ctx context.Context, id primitive.ObjectID, usedAt time.Time, appleRefreshToken string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	set := bson.M{"last_used_at": usedAt}
+	if appleRefreshToken != "" {
+		set["apple_refresh_token"] = appleRefreshToken
+	}
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": set})
+	return err
+}
+
+func (r *identityRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *identityRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NicknameHistoryRepository interface {
+	Create(ctx context.Context, history *model.NicknameHistory) error
+	FindLatestByUserID(ctx context.Context, userID primitive.ObjectID) (*model.NicknameHistory, error)
+	FindByUserIDsSince(ctx context.Context, userIDs []primitive.ObjectID, since time.Time) ([]model.NicknameHistory, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type nicknameHistoryRepository struct {
+	coll *mongo.Collection
+}
+
+func NewNicknameHistoryRepository(db *mongo.Database) NicknameHistoryRepository {
+	return &nicknameHistoryRepository{coll: db.Collection("nickname_histories")}
+}
+
+func (r *nicknameHistoryRepository) Create(ctx context.Context, history *model.NicknameHistory) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, history)
+	if err != nil {
+		return err
+	}
+	history.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *nicknameHistoryRepository) FindLatestByUserID(ctx context.Context, userID primitive.ObjectID) (*model.NicknameHistory, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "changed_at", Value: -1}})
+
+	var history model.NicknameHistory
+	err := r.coll.FindOne(ctx, bson.M{"user_id": userID}, opts).Decode(&history)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &history, err
+}
+
+// changed_at asc 정렬로 반환한다.
+func (r *nicknameHistoryRepository) FindByUserIDsSince(ctx context.Context, userIDs []primitive.ObjectID, since time.Time) ([]model.NicknameHistory, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "changed_at", Value: 1}})
+	cursor, err := r.coll.Find(ctx, bson.M{
+		"user_id":    bson.M{"$in": userIDs},
+		"changed_at": bson.M{"$gte": since},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var histories []model.NicknameHistory
+	if err := cursor.All(ctx, &histories); err != nil {
+		return nil, err
+	}
+	return histories, nil
+}
+
+func (r *nicknameHistoryRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *nicknameHistoryRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
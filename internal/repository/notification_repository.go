@@ -17,6 +17,7 @@ type NotificationRepository interface {
 	FindByUserID(ctx context.Context, userID primitive.ObjectID, limit int, offset int) ([]model.Notification, error)
 	MarkAsRead(ctx context.Context, notifID primitive.ObjectID, userID primitive.ObjectID) (int64, error)
 	CountUnread(ctx context.Context, userID primitive.ObjectID) (int, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
 }
 
 type notificationRepository struct {
@@ -86,3 +87,11 @@ func (r *notificationRepository) CountUnread(ctx context.Context, userID primiti
 	}
 	return int(count), nil
 }
+
+func (r *notificationRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NudgeRepository interface {
+	Create(ctx context.Context, nudge *model.Nudge) error
+	FindLatest(ctx context.Context, senderID, receiverID primitive.ObjectID) (*model.Nudge, error)
+	CountByReceiverAndTargetDay(ctx context.Context, receiverID primitive.ObjectID, targetDay string) (int64, error)
+	FindByReceiverAndTargetDay(ctx context.Context, receiverID primitive.ObjectID, targetDay string) ([]model.Nudge, error)
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Nudge, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type nudgeRepository struct {
+	coll *mongo.Collection
+}
+
+func NewNudgeRepository(db *mongo.Database) NudgeRepository {
+	return &nudgeRepository{coll: db.Collection("nudges")}
+}
+
+// Create 로 쌓인 기록은 created_at TTL 인덱스로 며칠 뒤 지워진다 (README 참고).
+func (r *nudgeRepository) Create(ctx context.Context, nudge *model.Nudge) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, nudge)
+	if err != nil {
+		return err
+	}
+	nudge.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+// FindLatest 는 sender 가 receiver 를 마지막으로 찌른 기록을 반환한다.
+func (r *nudgeRepository) FindLatest(ctx context.Context, senderID, receiverID primitive.ObjectID) (*model.Nudge, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	var nudge model.Nudge
+	err := r.coll.FindOne(ctx, bson.M{
+		"sender_id":   senderID,
+		"receiver_id": receiverID,
+	}, opts).Decode(&nudge)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &nudge, err
+}
+
+func (r *nudgeRepository) CountByReceiverAndTargetDay(ctx context.Context, receiverID primitive.ObjectID, targetDay string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return r.coll.CountDocuments(ctx, bson.M{
+		"receiver_id": receiverID,
+		"target_day":  targetDay,
+	})
+}
+
+// FindByReceiverAndTargetDay 는 해당 날짜에 받은 찌르기를 최신순으로 반환한다.
+func (r *nudgeRepository) FindByReceiverAndTargetDay(ctx context.Context, receiverID primitive.ObjectID, targetDay string) ([]model.Nudge, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.coll.Find(ctx, bson.M{
+		"receiver_id": receiverID,
+		"target_day":  targetDay,
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var nudges []model.Nudge
+	if err := cursor.All(ctx, &nudges); err != nil {
+		return nil, err
+	}
+	return nudges, nil
+}
+
+// FindByUserID 는 보내거나 받은 찌르기를 모두 반환한다.
+func (r *nudgeRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Nudge, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.coll.Find(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"sender_id": userID},
+			bson.M{"receiver_id": userID},
+		},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var nudges []model.Nudge
+	if err := cursor.All(ctx, &nudges); err != nil {
+		return nil, err
+	}
+	return nudges, nil
+}
+
+func (r *nudgeRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{
+		"$or": bson.A{
+			bson.M{"sender_id": userID},
+			bson.M{"receiver_id": userID},
+		},
+	})
+	return err
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RateLimitRepository 는 여러 인스턴스가 함께 쓰는 토큰 버킷이다 (middleware.RateLimitStore).
+// 버킷은 expires_at 이후 가득 찬 상태와 같으므로 expires_at TTL 인덱스로 정리한다 (README 의 MongoDB Indexes 참고).
+// 인덱스가 없으면 키마다 문서가 계속 쌓인다.
+type RateLimitRepository interface {
+	Take(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error)
+}
+
+type rateLimitRepository struct {
+	coll *mongo.Collection
+}
+
+func NewRateLimitRepository(db *mongo.Database) RateLimitRepository {
+	return &rateLimitRepository{coll: db.Collection("rate_limits")}
+}
+
+// Take 는 버킷 채우기와 토큰 차감을 한 번의 업데이트로 처리해 동시 요청에도 토큰이 중복 사용되지 않게 한다.
+func (r *rateLimitRepository) Take(ctx context.Context, key string, limit int, window time.Duration) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	capacity := float64(limit)
+	perMs := capacity / float64(window.Milliseconds())
+
+	elapsedMs := bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{now, bson.M{"$ifNull": bson.A{"$updated_at", now}}}}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"tokens": bson.M{"$min": bson.A{capacity, bson.M{"$add": bson.A{
+				bson.M{"$ifNull": bson.A{"$tokens", capacity}},
+				bson.M{"$multiply": bson.A{elapsedMs, perMs}},
+			}}}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"allowed":    bson.M{"$gte": bson.A{"$tokens", 1}},
+			"tokens":     bson.M{"$cond": bson.A{bson.M{"$gte": bson.A{"$tokens", 1}}, bson.M{"$subtract": bson.A{"$tokens", 1}}, "$tokens"}},
+			"updated_at": now,
+			"expires_at": now.Add(window),
+		}}},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var bucket struct {
+		Tokens  float64 `bson:"tokens"`
+		Allowed bool    `bson:"allowed"`
+	}
+	err := r.coll.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	// 같은 키의 첫 요청이 동시에 들어오면 upsert 하나가 실패하므로 한 번 더 시도
+	if mongo.IsDuplicateKeyError(err) {
+		err = r.coll.FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if bucket.Allowed {
+		return 0, nil
+	}
+	return time.Duration((1 - bucket.Tokens) / perMs * float64(time.Millisecond)), nil
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ReactionCount struct {
+	SessionID primitive.ObjectID  `bson:"session_id"`
+	Emoji     model.ReactionEmoji `bson:"emoji"`
+	Count     int                 `bson:"count"`
+}
+
+type SessionReactionRepository interface {
+	Upsert(ctx context.Context, reaction *model.SessionReaction) error
+	Delete(ctx context.Context, sessionID, userID primitive.ObjectID) (bool, error)
+	CountBySessionIDs(ctx context.Context, sessionIDs []primitive.ObjectID) ([]ReactionCount, error)
+	FindByUserAndSessionIDs(ctx context.Context, userID primitive.ObjectID, sessionIDs []primitive.ObjectID) ([]model.SessionReaction, error)
+	FindUnnotified(ctx context.Context, limit int64) ([]model.SessionReaction, error)
+	ClaimNotified(ctx context.Context, ids []primitive.ObjectID, batchID primitive.ObjectID) ([]model.SessionReaction, error)
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.SessionReaction, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type sessionReactionRepository struct {
+	coll *mongo.Collection
+}
+
+func NewSessionReactionRepository(db *mongo.Database) SessionReactionRepository {
+	return &sessionReactionRepository{coll: db.Collection("session_reactions")}
+}
+
+// Upsert 는 같은 세션에 남긴 반응이 있으면 이모지만 바꾼다. 알림은 처음 반응할 때만 보낸다.
+// (session_id, user_id) 유니크 인덱스(README 의 MongoDB Indexes 참고)로 세션당 하나를 보장한다.
+func (r *sessionReactionRepository) Upsert(ctx context.Context, reaction *model.SessionReaction) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Update().SetUpsert(true)
+	filter := bson.M{"session_id": reaction.SessionID, "user_id": reaction.UserID}
+	update := bson.M{
+		"$set": bson.M{"emoji": reaction.Emoji, "updated_at": reaction.UpdatedAt},
+		"$setOnInsert": bson.M{
+			"owner_id":   reaction.OwnerID,
+			"notified":   false,
+			"created_at": reaction.CreatedAt,
+		},
+	}
+	_, err := r.coll.UpdateOne(ctx, filter, update, opts)
+	// 같은 세션의 첫 반응이 동시에 들어오면 upsert 하나가 실패하므로 한 번 더 시도
+	if mongo.IsDuplicateKeyError(err) {
+		_, err = r.coll.UpdateOne(ctx, filter, update, opts)
+	}
+	return err
+}
+
+func (r *sessionReactionRepository) Delete(ctx context.Context, sessionID, userID primitive.ObjectID) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.DeleteOne(ctx, bson.M{"session_id": sessionID, "user_id": userID})
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// CountBySessionIDs 는 세션별·이모지별 반응 수를 반환한다.
+func (r *sessionReactionRepository) CountBySessionIDs(ctx context.Context, sessionIDs []primitive.ObjectID) ([]ReactionCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"session_id": bson.M{"$in": sessionIDs}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"session_id": "$session_id", "emoji": "$emoji"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":        0,
+			"session_id": "$_id.session_id",
+			"emoji":      "$_id.emoji",
+			"count":      1,
+		}}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var counts []ReactionCount
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *sessionReactionRepository) FindByUserAndSessionIDs(ctx context.Context, userID primitive.ObjectID, sessionIDs []primitive.ObjectID) ([]model.SessionReaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID, "session_id": bson.M{"$in": sessionIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var reactions []model.SessionReaction
+	if err := cursor.All(ctx, &reactions); err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
+
+// FindUnnotified 는 아직 세션 주인에게 알리지 않은 반응을 오래된 순으로 반환한다.
+func (r *sessionReactionRepository) FindUnnotified(ctx context.Context, limit int64) ([]model.SessionReaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetLimit(limit)
+	cursor, err := r.coll.Find(ctx, bson.M{"notified": false}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var reactions []model.SessionReaction
+	if err := cursor.All(ctx, &reactions); err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
+
+// ClaimNotified 는 ids 중 아직 알리지 않은 반응을 batchID 로 알림 처리하고, 이번에 처리된 반응만 반환한다.
+// 다른 인스턴스가 먼저 처리한 반응은 빠지므로 같은 반응으로 알림이 두 번 가지 않는다.
+func (r *sessionReactionRepository) ClaimNotified(ctx context.Context, ids []primitive.ObjectID, batchID primitive.ObjectID) ([]model.SessionReaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}, "notified": false},
+		bson.M{"$set": bson.M{"notified": true, "notify_batch_id": batchID}},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "notify_batch_id": batchID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var reactions []model.SessionReaction
+	if err := cursor.All(ctx, &reactions); err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
+
+// FindByUserID 는 유저가 남긴 반응을 반환한다.
+func (r *sessionReactionRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.SessionReaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var reactions []model.SessionReaction
+	if err := cursor.All(ctx, &reactions); err != nil {
+		return nil, err
+	}
+	return reactions, nil
+}
+
+// DeleteByUserID 는 유저가 남긴 반응과 유저의 세션에 달린 반응을 지운다.
+func (r *sessionReactionRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"$or": bson.A{
+		bson.M{"user_id": userID},
+		bson.M{"owner_id": userID},
+	}})
+	return err
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *model.Session) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Session, error)
+	FindActiveByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Session, error)
+	Touch(ctx context.Context, id primitive.ObjectID, seenAt time.Time) error
+	Revoke(ctx context.Context, id primitive.ObjectID) error
+	RevokeOthers(ctx context.Context, userID primitive.ObjectID, keepID primitive.ObjectID) ([]primitive.ObjectID, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type sessionRepository struct {
+	coll *mongo.Collection
+}
+
+func NewSessionRepository(db *mongo.Database) SessionRepository {
+	return &sessionRepository{coll: db.Collection("sessions")}
+}
+
+func (r *sessionRepository) Create(ctx context.Context, session *model.Session) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, session)
+	if err != nil {
+		return err
+	}
+	session.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *sessionRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var session model.Session
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&session)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &session, err
+}
+
+// FindActiveByUserID 는 폐기되지 않은 세션을 최근 사용 순으로 반환한다.
+func (r *sessionRepository) FindActiveByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.Session, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "last_seen_at", Value: -1}})
+	cursor, err := r.coll.Find(ctx, bson.M{
+		"user_id":    userID,
+		"revoked_at": bson.M{"$exists": false},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []model.Session
+	if err := cursor.All(ctx, &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (r *sessionRepository) Touch(ctx context.Context, id primitive.ObjectID, seenAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{"$set": bson.M{"last_seen_at": seenAt}})
+	return err
+}
+
+func (r *sessionRepository) Revoke(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "revoked_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revoked_at": time.Now()}},
+	)
+	return err
+}
+
+// RevokeOthers 는 keepID 를 제외한 유저의 활성 세션을 폐기하고 폐기한 세션 ID 를 반환한다.
+func (r *sessionRepository) RevokeOthers(ctx context.Context, userID primitive.ObjectID, keepID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"user_id":    userID,
+		"_id":        bson.M{"$ne": keepID},
+		"revoked_at": bson.M{"$exists": false},
+	}
+
+	cursor, err := r.coll.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []model.Session
+	if err := cursor.All(ctx, &sessions); err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]primitive.ObjectID, len(sessions))
+	for i, s := range sessions {
+		ids[i] = s.ID
+	}
+
+	_, err = r.coll.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"revoked_at": time.Now()}},
+	)
+	return ids, err
+}
+
+func (r *sessionRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -23,6 +23,7 @@ type StatRepository interface {
 	GetBucketCache(ctx context.Context, targetDay string) ([]model.VoidStatCache, error)
 	UpsertBucketCache(ctx context.Context, caches []model.VoidStatCache) error
 	GetUserDurations(ctx context.Context, targetDay string) ([]UserDuration, error)
+	DeleteBucketCache(ctx context.Context, targetDays []string) error
 }
 
 type statRepository struct {
@@ -43,7 +44,7 @@ func (r *statRepository) CountCurrentVoid(ctx context.Context) (int, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	count, err := r.usersColl.CountDocuments(ctx, bson.M{"is_in_void": true})
+	count, err := r.usersColl.CountDocuments(ctx, bson.M{"is_in_void": true, "deletion_requested_at": notPendingDeletion})
 	if err != nil {
 		return 0, err
 	}
@@ -54,7 +55,7 @@ func (r *statRepository) CountDistinctUsersForDay(ctx context.Context, targetDay
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	result, err := r.sessionsColl.Distinct(ctx, "user_id", bson.M{"target_day": targetDay})
+	result, err := r.sessionsColl.Distinct(ctx, "user_id", bson.M{"target_day": targetDay, "hidden": bson.M{"$ne": true}})
 	if err != nil {
 		return 0, err
 	}
@@ -107,7 +108,7 @@ func (r *statRepository) GetUserDurations(ctx context.Context, targetDay string)
 	defer cancel()
 
 	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: bson.M{"target_day": targetDay}}},
+		{{Key: "$match", Value: bson.M{"target_day": targetDay, "hidden": bson.M{"$ne": true}}}},
 		{{Key: "$group", Value: bson.M{
 			"_id":           "$user_id",
 			"total_dur_sec": bson.M{"$sum": "$duration_sec"},
@@ -127,3 +128,16 @@ func (r *statRepository) GetUserDurations(ctx context.Context, targetDay string)
 	}
 	return results, nil
 }
+
+// DeleteBucketCache 는 해당 날짜의 버킷 캐시를 지운다. 다음 조회 때 세션에서 다시 계산된다.
+func (r *statRepository) DeleteBucketCache(ctx context.Context, targetDays []string) error {
+	if len(targetDays) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := r.cacheColl.DeleteMany(ctx, bson.M{"target_day": bson.M{"$in": targetDays}})
+	return err
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SuggestionDismissalRepository interface {
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.SuggestionDismissal, error)
+	Create(ctx context.Context, userID, dismissedID primitive.ObjectID) error
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type suggestionDismissalRepository struct {
+	coll *mongo.Collection
+}
+
+func NewSuggestionDismissalRepository(db *mongo.Database) SuggestionDismissalRepository {
+	return &suggestionDismissalRepository{coll: db.Collection("suggestion_dismissals")}
+}
+
+func (r *suggestionDismissalRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.SuggestionDismissal, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var dismissals []model.SuggestionDismissal
+	if err := cursor.All(ctx, &dismissals); err != nil {
+		return nil, err
+	}
+	return dismissals, nil
+}
+
+// Create 는 이미 숨긴 유저면 아무것도 하지 않는다.
+func (r *suggestionDismissalRepository) Create(ctx context.Context, userID, dismissedID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"user_id": userID, "dismissed_id": dismissedID},
+		bson.M{"$setOnInsert": bson.M{"created_at": time.Now()}},
+		opts,
+	)
+	return err
+}
+
+func (r *suggestionDismissalRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"$or": bson.A{
+		bson.M{"user_id": userID},
+		bson.M{"dismissed_id": userID},
+	}})
+	return err
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TagReservationRepository interface {
+	FindActiveByTag(ctx context.Context, tag string) (*model.TagReservation, error)
+	Upsert(ctx context.Context, reservation *model.TagReservation) error
+	DeleteByTag(ctx context.Context, tag string) error
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type tagReservationRepository struct {
+	coll *mongo.Collection
+}
+
+func NewTagReservationRepository(db *mongo.Database) TagReservationRepository {
+	return &tagReservationRepository{coll: db.Collection("tag_reservations")}
+}
+
+func (r *tagReservationRepository) FindActiveByTag(ctx context.Context, tag string) (*model.TagReservation, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var reservation model.TagReservation
+	err := r.coll.FindOne(ctx, bson.M{
+		"tag":        tag,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}).Decode(&reservation)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &reservation, err
+}
+
+// tag unique index 를 기준으로 태그마다 예약은 하나만 남는다 (README 참고).
+func (r *tagReservationRepository) Upsert(ctx context.Context, reservation *model.TagReservation) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Update().SetUpsert(true)
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"tag": reservation.Tag},
+		bson.M{"$set": bson.M{
+			"user_id":    reservation.UserID,
+			"expires_at": reservation.ExpiresAt,
+			"created_at": reservation.CreatedAt,
+		}},
+		opts,
+	)
+	return err
+}
+
+func (r *tagReservationRepository) DeleteByTag(ctx context.Context, tag string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteOne(ctx, bson.M{"tag": tag})
+	return err
+}
+
+func (r *tagReservationRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -13,19 +13,31 @@ import (
 )
 
 type UserRepository interface {
-	FindBySocial(ctx context.Context, provider model.SocialProvider, socialID string) (*model.User, error)
 	FindByID(ctx context.Context, id primitive.ObjectID) (*model.User, error)
 	FindByTag(ctx context.Context, tag string) (*model.User, error)
 	Create(ctx context.Context, user *model.User) error
 	UpdateNickname(ctx context.Context, id primitive.ObjectID, nickname string) error
+	ReplaceNickname(ctx context.Context, id primitive.ObjectID, oldNickname, nickname string) (bool, error)
+	UpdateTag(ctx context.Context, id primitive.ObjectID, tag string, changedAt time.Time) error
 	UpdateSettings(ctx context.Context, id primitive.ObjectID, settings model.NotificationSettings) error
+	UpdateAvatar(ctx context.Context, id primitive.ObjectID, avatarKey string) error
+	UpdatePrivacySettings(ctx context.Context, id primitive.ObjectID, settings model.PrivacySettings) error
 	SetVoidState(ctx context.Context, id primitive.ObjectID, isInVoid bool, startedAt *time.Time, lastVoidEndedAt *time.Time) error
 	DeleteByID(ctx context.Context, id primitive.ObjectID) error
 	SearchByTagPrefix(ctx context.Context, prefix string, excludeIDs []primitive.ObjectID, limit int) ([]model.User, error)
 	FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]model.User, error)
 	FindUsersInVoid(ctx context.Context) ([]model.User, error)
+	FindByIDIncludingPendingDeletion(ctx context.Context, id primitive.ObjectID) (*model.User, error)
+	MarkDeletionRequested(ctx context.Context, id primitive.ObjectID, requestedAt time.Time) error
+	ClearDeletionRequested(ctx context.Context, id primitive.ObjectID) error
+	FindPendingDeletionBefore(ctx context.Context, before time.Time, limit int) ([]model.User, error)
+	FindLegacyIdentities(ctx context.Context) ([]model.Identity, error)
+	UnsetLegacyIdentity(ctx context.Context, id primitive.ObjectID) error
 }
 
+// notPendingDeletion 은 탈퇴 유예 중인 유저를 조회에서 제외하는 조건이다.
+var notPendingDeletion = bson.M{"$exists": false}
+
 type userRepository struct {
 	coll *mongo.Collection
 }
@@ -34,22 +46,20 @@ func NewUserRepository(db *mongo.Database) UserRepository {
 	return &userRepository{coll: db.Collection("users")}
 }
 
-func (r *userRepository) FindBySocial(ctx context.Context, provider model.SocialProvider, socialID string) (*model.User, error) {
+func (r *userRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
 	var user model.User
-	err := r.coll.FindOne(ctx, bson.M{
-		"social_provider": provider,
-		"social_id":       socialID,
-	}).Decode(&user)
+	err := r.coll.FindOne(ctx, bson.M{"_id": id, "deletion_requested_at": notPendingDeletion}).Decode(&user)
 	if err == mongo.ErrNoDocuments {
 		return nil, nil
 	}
 	return &user, err
 }
 
-func (r *userRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.User, error) {
+// FindByIDIncludingPendingDeletion 은 탈퇴 유예 중인 유저도 조회한다. 로그인 복구와 완전 삭제에서만 사용한다.
+func (r *userRepository) FindByIDIncludingPendingDeletion(ctx context.Context, id primitive.ObjectID) (*model.User, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
@@ -66,7 +76,7 @@ func (r *userRepository) FindByTag(ctx context.Context, tag string) (*model.User
 	defer cancel()
 
 	var user model.User
-	err := r.coll.FindOne(ctx, bson.M{"tag": tag}).Decode(&user)
+	err := r.coll.FindOne(ctx, bson.M{"tag": tag, "deletion_requested_at": notPendingDeletion}).Decode(&user)
 	if err == mongo.ErrNoDocuments {
 		return nil, nil
 	}
@@ -95,6 +105,30 @@ func (r *userRepository) UpdateNickname(ctx context.Context, id primitive.Object
 	return err
 }
 
+// ReplaceNickname 은 닉네임이 아직 oldNickname 일 때만 바꾼다. 다른 요청이 먼저 바꿨으면 false 를 반환한다.
+func (r *userRepository) ReplaceNickname(ctx context.Context, id primitive.ObjectID, oldNickname, nickname string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.UpdateOne(ctx, bson.M{"_id": id, "nickname": oldNickname}, bson.M{
+		"$set": bson.M{"nickname": nickname, "updated_at": time.Now()},
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (r *userRepository) UpdateTag(ctx context.Context, id primitive.ObjectID, tag string, changedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{"tag": tag, "tag_changed_at": changedAt, "updated_at": time.Now()},
+	})
+	return err
+}
+
 func (r *userRepository) UpdateSettings(ctx context.Context, id primitive.ObjectID, settings model.NotificationSettings) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -105,6 +139,33 @@ func (r *userRepository) UpdateSettings(ctx context.Context, id primitive.Object
 	return err
 }
 
+func (r *userRepository) UpdatePrivacySettings(ctx context.Context, id primitive.ObjectID, settings model.PrivacySettings) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set": bson.M{"privacy_settings": settings, "updated_at": time.Now()},
+	})
+	return err
+}
+
+// avatarKey 가 비어 있으면 아바타를 삭제한다.
+func (r *userRepository) UpdateAvatar(ctx context.Context, id primitive.ObjectID, avatarKey string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"avatar_key": avatarKey, "updated_at": time.Now()}}
+	if avatarKey == "" {
+		update = bson.M{
+			"$set":   bson.M{"updated_at": time.Now()},
+			"$unset": bson.M{"avatar_key": ""},
+		}
+	}
+
+	_, err := r.coll.UpdateByID(ctx, id, update)
+	return err
+}
+
 func (r *userRepository) SetVoidState(ctx context.Context, id primitive.ObjectID, isInVoid bool, startedAt *time.Time, lastVoidEndedAt *time.Time) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -135,7 +196,8 @@ func (r *userRepository) SearchByTagPrefix(ctx context.Context, prefix string, e
 	defer cancel()
 
 	filter := bson.M{
-		"tag": bson.M{"$regex": "^" + prefix, "$options": "i"},
+		"tag":                   bson.M{"$regex": "^" + prefix, "$options": "i"},
+		"deletion_requested_at": notPendingDeletion,
 	}
 	if len(excludeIDs) > 0 {
 		filter["_id"] = bson.M{"$nin": excludeIDs}
@@ -159,7 +221,7 @@ func (r *userRepository) FindByIDs(ctx context.Context, ids []primitive.ObjectID
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	cursor, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	cursor, err := r.coll.Find(ctx, bson.M{"_id": bson.M{"$in": ids}, "deletion_requested_at": notPendingDeletion})
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +238,7 @@ func (r *userRepository) FindUsersInVoid(ctx context.Context) ([]model.User, err
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	cursor, err := r.coll.Find(ctx, bson.M{"is_in_void": true})
+	cursor, err := r.coll.Find(ctx, bson.M{"is_in_void": true, "deletion_requested_at": notPendingDeletion})
 	if err != nil {
 		return nil, err
 	}
@@ -188,3 +250,99 @@ func (r *userRepository) FindUsersInVoid(ctx context.Context) ([]model.User, err
 	}
 	return result, nil
 }
+
+// MarkDeletionRequested 는 탈퇴 유예를 시작한다. 진행 중인 밤샘 기록은 저장하지 않고 종료한다.
+func (r *userRepository) MarkDeletionRequested(ctx context.Context, id primitive.ObjectID, requestedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set":   bson.M{"deletion_requested_at": requestedAt, "is_in_void": false, "updated_at": time.Now()},
+		"$unset": bson.M{"current_void_started_at": ""},
+	})
+	return err
+}
+
+func (r *userRepository) ClearDeletionRequested(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$unset": bson.M{"deletion_requested_at": ""},
+	})
+	return err
+}
+
+// FindPendingDeletionBefore 는 before 이전에 탈퇴를 요청한 유저를 오래된 순으로 반환한다.
+func (r *userRepository) FindPendingDeletionBefore(ctx context.Context, before time.Time, limit int) ([]model.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "deletion_requested_at", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.coll.Find(ctx, bson.M{"deletion_requested_at": bson.M{"$lt": before}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []model.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// FindLegacyIdentities 는 users 문서에 남아 있는 예전 소셜 계정 필드(social_provider, social_id)를
+// identities 컬렉션으로 옮기기 위해 읽는다.
+func (r *userRepository) FindLegacyIdentities(ctx context.Context) ([]model.Identity, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetProjection(bson.M{
+		"social_provider":     1,
+		"social_id":           1,
+		"apple_refresh_token": 1,
+		"created_at":          1,
+	})
+	cursor, err := r.coll.Find(ctx, bson.M{"social_id": bson.M{"$exists": true}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var legacy []struct {
+		ID                primitive.ObjectID   `bson:"_id"`
+		SocialProvider    model.SocialProvider `bson:"social_provider"`
+		SocialID          string               `bson:"social_id"`
+		AppleRefreshToken string               `bson:"apple_refresh_token"`
+		CreatedAt         time.Time            `bson:"created_at"`
+	}
+	if err := cursor.All(ctx, &legacy); err != nil {
+		return nil, err
+	}
+
+	identities := make([]model.Identity, len(legacy))
+	for i, u := range legacy {
+		identities[i] = model.Identity{
+			UserID:            u.ID,
+			Provider:          u.SocialProvider,
+			SocialID:          u.SocialID,
+			AppleRefreshToken: u.AppleRefreshToken,
+			CreatedAt:         u.CreatedAt,
+		}
+	}
+	return identities, nil
+}
+
+func (r *userRepository) UnsetLegacyIdentity(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateByID(ctx, id, bson.M{
+		"$unset": bson.M{"social_provider": "", "social_id": "", "apple_refresh_token": ""},
+	})
+	return err
+}
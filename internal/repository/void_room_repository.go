@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"dangbamgong-backend/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type VoidRoomRepository interface {
+	Create(ctx context.Context, room *model.VoidRoom) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.VoidRoom, error)
+	FindOpenByMemberID(ctx context.Context, userID primitive.ObjectID) (*model.VoidRoom, error)
+	FindByParticipantID(ctx context.Context, userID primitive.ObjectID) ([]model.VoidRoom, error)
+	Invite(ctx context.Context, id primitive.ObjectID, userIDs []primitive.ObjectID) error
+	Join(ctx context.Context, id, userID primitive.ObjectID, maxMembers int) (bool, error)
+	Leave(ctx context.Context, id, userID primitive.ObjectID) (*model.VoidRoom, error)
+	Touch(ctx context.Context, id primitive.ObjectID, activeAt time.Time) error
+	Close(ctx context.Context, id primitive.ObjectID, totalDurationSec int64, sessionCount int, closedAt time.Time) (bool, error)
+	FindIdle(ctx context.Context, activeBefore time.Time, limit int) ([]model.VoidRoom, error)
+	CloseIdle(ctx context.Context, id primitive.ObjectID, activeBefore time.Time, totalDurationSec int64, sessionCount int, closedAt time.Time) (bool, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type voidRoomRepository struct {
+	coll *mongo.Collection
+}
+
+func NewVoidRoomRepository(db *mongo.Database) VoidRoomRepository {
+	return &voidRoomRepository{coll: db.Collection("void_rooms")}
+}
+
+func (r *voidRoomRepository) Create(ctx context.Context, room *model.VoidRoom) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.InsertOne(ctx, room)
+	if err != nil {
+		return err
+	}
+	room.ID = result.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *voidRoomRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.VoidRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var room model.VoidRoom
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&room)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &room, err
+}
+
+// FindOpenByMemberID 는 유저가 지금 들어가 있는 방을 반환한다. 유저는 한 번에 한 방에만 있을 수 있다.
+func (r *voidRoomRepository) FindOpenByMemberID(ctx context.Context, userID primitive.ObjectID) (*model.VoidRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var room model.VoidRoom
+	err := r.coll.FindOne(ctx, bson.M{"member_ids": userID, "status": model.VoidRoomOpen}).Decode(&room)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &room, err
+}
+
+// FindByParticipantID 는 유저가 들어갔던 방을 최신순으로 반환한다.
+func (r *voidRoomRepository) FindByParticipantID(ctx context.Context, userID primitive.ObjectID) ([]model.VoidRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.coll.Find(ctx, bson.M{"participant_ids": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rooms []model.VoidRoom
+	if err := cursor.All(ctx, &rooms); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+func (r *voidRoomRepository) Invite(ctx context.Context, id primitive.ObjectID, userIDs []primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": model.VoidRoomOpen},
+		bson.M{"$addToSet": bson.M{"invited_ids": bson.M{"$each": userIDs}}},
+	)
+	return err
+}
+
+// Join 은 초대받은 유저를 열린 방에 넣는다. 방이 닫혔거나 꽉 찼거나 초대받지 않았으면 false.
+func (r *voidRoomRepository) Join(ctx context.Context, id, userID primitive.ObjectID, maxMembers int) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"_id":         id,
+		"status":      model.VoidRoomOpen,
+		"invited_ids": userID,
+		"member_ids":  bson.M{"$ne": userID},
+		// 멤버 수 확인과 추가를 한 번에 처리해 동시에 들어와도 정원을 넘지 않도록 함
+		fmt.Sprintf("member_ids.%d", maxMembers-1): bson.M{"$exists": false},
+	}
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{
+		"$push":     bson.M{"member_ids": userID},
+		"$addToSet": bson.M{"participant_ids": userID},
+		"$set":      bson.M{"active_at": time.Now()},
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// Leave 는 유저를 방에서 빼고 바뀐 방을 반환한다. 멤버가 아니었으면 nil.
+func (r *voidRoomRepository) Leave(ctx context.Context, id, userID primitive.ObjectID) (*model.VoidRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var room model.VoidRoom
+	err := r.coll.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "status": model.VoidRoomOpen, "member_ids": userID},
+		bson.M{
+			"$pull": bson.M{"member_ids": userID},
+			"$set":  bson.M{"active_at": time.Now()},
+		},
+		opts,
+	).Decode(&room)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &room, err
+}
+
+func (r *voidRoomRepository) Touch(ctx context.Context, id primitive.ObjectID, activeAt time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": model.VoidRoomOpen},
+		bson.M{"$set": bson.M{"active_at": activeAt}},
+	)
+	return err
+}
+
+// Close 는 빈 방을 닫고 합계를 기록한다. 그사이 누가 들어왔거나 이미 닫혔으면 false.
+func (r *voidRoomRepository) Close(ctx context.Context, id primitive.ObjectID, totalDurationSec int64, sessionCount int, closedAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.UpdateOne(ctx,
+		bson.M{"_id": id, "status": model.VoidRoomOpen, "member_ids": bson.M{"$size": 0}},
+		bson.M{"$set": bson.M{
+			"status":             model.VoidRoomClosed,
+			"total_duration_sec": totalDurationSec,
+			"session_count":      sessionCount,
+			"closed_at":          closedAt,
+		}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// idleFilter 는 activeBefore 이후로 활동이 없는 열린 방의 조건이다. active_at 이 없는 예전 방은 created_at 으로 판단한다.
+func idleFilter(activeBefore time.Time) bson.M {
+	return bson.M{
+		"status": model.VoidRoomOpen,
+		"$or": bson.A{
+			bson.M{"active_at": bson.M{"$lt": activeBefore}},
+			bson.M{"active_at": bson.M{"$exists": false}, "created_at": bson.M{"$lt": activeBefore}},
+		},
+	}
+}
+
+// FindIdle 은 activeBefore 이후로 활동이 없는 열린 방을 오래된 순으로 반환한다.
+func (r *voidRoomRepository) FindIdle(ctx context.Context, activeBefore time.Time, limit int) ([]model.VoidRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.coll.Find(ctx, idleFilter(activeBefore), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rooms []model.VoidRoom
+	if err := cursor.All(ctx, &rooms); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+// CloseIdle 은 남은 멤버와 관계없이 방을 닫는다. 그사이 활동이 있었거나 이미 닫혔으면 false.
+func (r *voidRoomRepository) CloseIdle(ctx context.Context, id primitive.ObjectID, activeBefore time.Time, totalDurationSec int64, sessionCount int, closedAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := idleFilter(activeBefore)
+	filter["_id"] = id
+	result, err := r.coll.UpdateOne(ctx, filter, bson.M{"$set": bson.M{
+		"status":             model.VoidRoomClosed,
+		"total_duration_sec": totalDurationSec,
+		"session_count":      sessionCount,
+		"closed_at":          closedAt,
+	}})
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// DeleteByUserID 는 유저를 모든 방에서 빼고, 그 결과 아무도 들어온 적 없는 방이 된 방을 지운다.
+// 열린 방은 탈퇴 요청 때 이미 나갔으므로 여기서는 닫지 않는다.
+func (r *voidRoomRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"member_ids": userID},
+		bson.M{"participant_ids": userID},
+		bson.M{"invited_ids": userID},
+	}}
+	cursor, err := r.coll.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	var rooms []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &rooms); err != nil {
+		return err
+	}
+	if len(rooms) == 0 {
+		return nil
+	}
+	ids := make([]primitive.ObjectID, len(rooms))
+	for i, room := range rooms {
+		ids[i] = room.ID
+	}
+
+	_, err = r.coll.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$pull": bson.M{
+			"member_ids":      userID,
+			"participant_ids": userID,
+			"invited_ids":     userID,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.coll.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}, "participant_ids": bson.M{"$size": 0}})
+	return err
+}
@@ -9,14 +9,21 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type VoidSessionRepository interface {
 	Create(ctx context.Context, session *model.VoidSession) error
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.VoidSession, error)
+	AggregateRoomDurations(ctx context.Context, roomID primitive.ObjectID) ([]model.VoidRoomDuration, error)
 	FindByUserIDAndTargetDay(ctx context.Context, userID primitive.ObjectID, targetDay string) ([]model.VoidSession, error)
 	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
 	FindByTargetDay(ctx context.Context, targetDay string) ([]model.VoidSession, error)
 	AggregateUserStats(ctx context.Context, userID primitive.ObjectID) (*model.VoidUserStats, error)
+	AggregateDailyDurations(ctx context.Context, userID primitive.ObjectID, fromDay string) ([]model.VoidDailyDuration, error)
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.VoidSession, error)
+	FindTargetDaysByUserID(ctx context.Context, userID primitive.ObjectID) ([]string, error)
+	SetHiddenByUserID(ctx context.Context, userID primitive.ObjectID, hidden bool) error
 }
 
 type voidSessionRepository struct {
@@ -39,6 +46,18 @@ func (r *voidSessionRepository) Create(ctx context.Context, session *model.VoidS
 	return nil
 }
 
+func (r *voidSessionRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.VoidSession, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var session model.VoidSession
+	err := r.coll.FindOne(ctx, bson.M{"_id": id}).Decode(&session)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	return &session, err
+}
+
 func (r *voidSessionRepository) FindByUserIDAndTargetDay(ctx context.Context, userID primitive.ObjectID, targetDay string) ([]model.VoidSession, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -68,7 +87,7 @@ func (r *voidSessionRepository) FindByTargetDay(ctx context.Context, targetDay s
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	cursor, err := r.coll.Find(ctx, bson.M{"target_day": targetDay})
+	cursor, err := r.coll.Find(ctx, bson.M{"target_day": targetDay, "hidden": bson.M{"$ne": true}})
 	if err != nil {
 		return nil, err
 	}
@@ -111,3 +130,108 @@ func (r *voidSessionRepository) AggregateUserStats(ctx context.Context, userID p
 	}
 	return &results[0], nil
 }
+
+// AggregateRoomDurations 는 방에 연결된 공백을 유저별로 합산한다. 탈퇴 유예 중인 유저의 세션은 제외한다.
+func (r *voidSessionRepository) AggregateRoomDurations(ctx context.Context, roomID primitive.ObjectID) ([]model.VoidRoomDuration, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"room_id": roomID, "hidden": bson.M{"$ne": true}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":                "$user_id",
+			"total_duration_sec": bson.M{"$sum": "$duration_sec"},
+			"session_count":      bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []model.VoidRoomDuration
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// AggregateDailyDurations 는 fromDay 이후 날짜별 총 공백 시간을 최신 날짜부터 반환한다.
+func (r *voidSessionRepository) AggregateDailyDurations(ctx context.Context, userID primitive.ObjectID, fromDay string) ([]model.VoidDailyDuration, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "target_day": bson.M{"$gte": fromDay}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":                "$target_day",
+			"total_duration_sec": bson.M{"$sum": "$duration_sec"},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": -1}}},
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []model.VoidDailyDuration
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// FindByUserID 는 유저의 모든 세션을 시작 시각 순으로 반환한다.
+func (r *voidSessionRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) ([]model.VoidSession, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "started_at", Value: 1}})
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var sessions []model.VoidSession
+	if err := cursor.All(ctx, &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// FindTargetDaysByUserID 는 유저의 세션이 있는 날짜 목록을 반환한다.
+func (r *voidSessionRepository) FindTargetDaysByUserID(ctx context.Context, userID primitive.ObjectID) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := r.coll.Distinct(ctx, "target_day", bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]string, 0, len(result))
+	for _, v := range result {
+		if day, ok := v.(string); ok {
+			days = append(days, day)
+		}
+	}
+	return days, nil
+}
+
+// SetHiddenByUserID 는 유저의 세션을 전체 통계에서 제외하거나 다시 포함한다.
+func (r *voidSessionRepository) SetHiddenByUserID(ctx context.Context, userID primitive.ObjectID, hidden bool) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"hidden": true}}
+	if !hidden {
+		update = bson.M{"$unset": bson.M{"hidden": ""}}
+	}
+	_, err := r.coll.UpdateMany(ctx, bson.M{"user_id": userID}, update)
+	return err
+}
@@ -3,9 +3,11 @@ package server
 import (
 	"net/http"
 	"os"
+	"time"
 
 	_ "dangbamgong-backend/docs"
 	"dangbamgong-backend/internal/middleware"
+	"dangbamgong-backend/internal/storage"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -25,6 +27,7 @@ func (s *Server) RegisterRoutes() http.Handler {
 	e := echo.New()
 	e.Validator = &customValidator{validator: validator.New()}
 	e.HTTPErrorHandler = middleware.ErrorHandler
+	e.IPExtractor = middleware.IPExtractor()
 
 	e.Use(echoMiddleware.LoggerWithConfig(echoMiddleware.LoggerConfig{
 		Format: "[\033[32m${time_rfc3339}\033[0m] ${status} | ${method} ${uri} | ${latency_human}\n",
@@ -40,73 +43,125 @@ func (s *Server) RegisterRoutes() http.Handler {
 
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
+	jwtAuth := middleware.JWTAuth(s.sessions)
+
+	// 기본값은 RATE_LIMIT_<NAME> 환경변수로 덮어쓸 수 있음
+	loginLimit := middleware.RateLimit(s.rateLimits, middleware.RateLimitPolicy{Name: "login", Limit: 10, Window: time.Minute, Key: middleware.KeyByIP})
+	refreshLimit := middleware.RateLimit(s.rateLimits, middleware.RateLimitPolicy{Name: "refresh", Limit: 30, Window: time.Minute, Key: middleware.KeyByIP})
+	searchLimit := middleware.RateLimit(s.rateLimits, middleware.RateLimitPolicy{Name: "search", Limit: 30, Window: time.Minute, Key: middleware.KeyByUser})
+	friendRequestLimit := middleware.RateLimit(s.rateLimits, middleware.RateLimitPolicy{Name: "friend_request", Limit: 20, Window: time.Hour, Key: middleware.KeyByUser})
+	nudgeLimit := middleware.RateLimit(s.rateLimits, middleware.RateLimitPolicy{Name: "nudge", Limit: 30, Window: time.Hour, Key: middleware.KeyByUser})
+	inviteRedeemLimit := middleware.RateLimit(s.rateLimits, middleware.RateLimitPolicy{Name: "invite_redeem", Limit: 20, Window: time.Hour, Key: middleware.KeyByUser})
+
+	// 로컬 저장소는 업로드 파일을 직접 서빙
+	if local, ok := s.blobStorage.(*storage.LocalStorage); ok {
+		e.Static(local.PublicPath, local.Dir)
+	}
+
 	e.GET("/", s.health.HelloWorld)
 	e.GET("/health", s.health.Health)
+	e.GET("/.well-known/jwks.json", s.auth.JWKS)
+
+	// 서명된 링크로 인증하므로 JWT 없이 접근
+	e.GET("/exports/:export_id/download", s.export.Download)
 
 	// Auth - public
 	authGroup := e.Group("/auth")
-	authGroup.POST("/login", s.auth.Login)
+	authGroup.POST("/login", s.auth.Login, loginLimit)
+	authGroup.POST("/refresh", s.auth.Refresh, refreshLimit)
 	if os.Getenv("APP_ENV") != "production" {
-		authGroup.POST("/login/test", s.auth.TestLogin)
+		authGroup.POST("/login/test", s.auth.TestLogin, loginLimit)
 	}
 
 	// Auth - protected
-	authProtected := authGroup.Group("", middleware.JWTAuth())
+	authProtected := authGroup.Group("", jwtAuth)
 	authProtected.POST("/nickname", s.auth.SetNickname)
 	authProtected.DELETE("/withdraw", s.auth.Withdraw)
+	authProtected.GET("/sessions", s.auth.GetSessions)
+	authProtected.POST("/logout", s.auth.Logout)
+	authProtected.POST("/logout/others", s.auth.LogoutOthers)
+	authProtected.GET("/identities", s.auth.GetIdentities)
+	authProtected.POST("/identities", s.auth.LinkIdentity)
+	authProtected.DELETE("/identities/:provider", s.auth.UnlinkIdentity)
 
 	// Activity - all protected
-	activityGroup := e.Group("/activities", middleware.JWTAuth())
+	activityGroup := e.Group("/activities", jwtAuth)
 	activityGroup.GET("", s.activity.List)
 	activityGroup.POST("", s.activity.Create)
 	activityGroup.PATCH("/:activity_id", s.activity.UpdateName)
 	activityGroup.DELETE("/:activity_id", s.activity.Delete)
 
 	// User - all protected
-	userGroup := e.Group("/users", middleware.JWTAuth())
-	userGroup.GET("/search", s.user.Search)
+	userGroup := e.Group("/users", jwtAuth)
+	userGroup.GET("/search", s.user.Search, searchLimit)
 	userGroup.GET("/me", s.user.GetMe)
 	userGroup.PATCH("/me/settings", s.user.UpdateSettings)
+	userGroup.PATCH("/me/privacy", s.user.UpdatePrivacy)
 	userGroup.PATCH("/me/nickname", s.user.ChangeNickname)
+	userGroup.PATCH("/me/tag", s.user.ChangeTag)
+	userGroup.PUT("/me/avatar", s.user.UploadAvatar)
+	userGroup.DELETE("/me/avatar", s.user.DeleteAvatar)
+	userGroup.POST("/me/export", s.export.RequestExport)
+	userGroup.GET("/me/export", s.export.GetExport)
 	userGroup.GET("/blocks", s.user.GetBlocks)
+	userGroup.GET("/:user_id", s.user.GetProfile)
 	userGroup.POST("/:user_id/block", s.user.Block)
 	userGroup.POST("/:user_id/unblock", s.user.Unblock)
 
 	// Void - all protected
-	voidGroup := e.Group("/void", middleware.JWTAuth())
+	voidGroup := e.Group("/void", jwtAuth)
 	voidGroup.POST("/start", s.void.Start)
 	voidGroup.POST("/end", s.void.End)
 	voidGroup.POST("/cancel", s.void.Cancel)
 	voidGroup.GET("/history", s.void.History)
+	voidGroup.PUT("/sessions/:session_id/reaction", s.void.React)
+	voidGroup.DELETE("/sessions/:session_id/reaction", s.void.RemoveReaction)
+	voidGroup.POST("/rooms", s.voidRoom.Create)
+	voidGroup.GET("/rooms/current", s.voidRoom.GetCurrent)
+	voidGroup.GET("/rooms/:room_id", s.voidRoom.Get)
+	voidGroup.POST("/rooms/:room_id/invite", s.voidRoom.Invite)
+	voidGroup.POST("/rooms/:room_id/join", s.voidRoom.Join)
+	voidGroup.POST("/rooms/:room_id/leave", s.voidRoom.Leave)
 	if os.Getenv("APP_ENV") != "production" {
 		voidGroup.POST("/test", s.void.TestCreate)
 	}
 
 	// Friend - all protected
-	friendGroup := e.Group("/friends", middleware.JWTAuth())
+	friendGroup := e.Group("/friends", jwtAuth)
 	friendGroup.GET("", s.friend.GetFriends)
+	friendGroup.GET("/feed", s.feed.GetFeed)
+	friendGroup.GET("/suggestions", s.friend.GetSuggestions)
+	friendGroup.POST("/suggestions/:user_id/dismiss", s.friend.DismissSuggestion)
 	friendGroup.DELETE("/:user_id", s.friend.RemoveFriend)
 	friendGroup.GET("/requests", s.friend.GetRequests)
-	friendGroup.POST("/requests", s.friend.SendRequest)
+	friendGroup.POST("/requests", s.friend.SendRequest, friendRequestLimit)
 	friendGroup.POST("/requests/:request_id/accept", s.friend.AcceptRequest)
 	friendGroup.POST("/requests/:request_id/reject", s.friend.RejectRequest)
 	friendGroup.DELETE("/requests/:request_id", s.friend.DeleteRequest)
-	friendGroup.POST("/:user_id/nudge", s.friend.Nudge)
+	friendGroup.POST("/invites", s.friend.CreateInvite)
+	friendGroup.POST("/invites/:code/redeem", s.friend.RedeemInvite, inviteRedeemLimit)
+	friendGroup.GET("/nudges", s.friend.GetNudges)
+	friendGroup.GET("/circles", s.circle.List)
+	friendGroup.POST("/circles", s.circle.Create)
+	friendGroup.PATCH("/circles/:circle_id", s.circle.UpdateName)
+	friendGroup.DELETE("/circles/:circle_id", s.circle.Delete)
+	friendGroup.PUT("/circles/:circle_id/members", s.circle.SetMembers)
+	friendGroup.POST("/:user_id/nudge", s.friend.Nudge, nudgeLimit)
 
 	// Stat - all protected
-	statGroup := e.Group("/stats", middleware.JWTAuth())
+	statGroup := e.Group("/stats", jwtAuth)
 	statGroup.GET("/home", s.stat.GetHomeStat)
 	statGroup.GET("/daily", s.stat.GetDailyStat)
 	statGroup.GET("/me", s.stat.GetMyVoidStat)
 
 	// Notification - all protected
-	notifGroup := e.Group("/notifications", middleware.JWTAuth())
+	notifGroup := e.Group("/notifications", jwtAuth)
 	notifGroup.GET("", s.notification.GetNotifications)
 	notifGroup.PATCH("/:notification_id/read", s.notification.MarkAsRead)
 	notifGroup.GET("/unread-count", s.notification.GetUnreadCount)
 
 	// Device - all protected
-	deviceGroup := e.Group("/devices", middleware.JWTAuth())
+	deviceGroup := e.Group("/devices", jwtAuth)
 	deviceGroup.PUT("/token", s.device.RegisterToken)
 	deviceGroup.DELETE("/token", s.device.DeleteToken)
 
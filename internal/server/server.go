@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"os"
 	"strconv"
@@ -12,35 +13,59 @@ import (
 
 	"dangbamgong-backend/internal/auth"
 	"dangbamgong-backend/internal/database"
+	"dangbamgong-backend/internal/filter"
 	"dangbamgong-backend/internal/handler"
+	"dangbamgong-backend/internal/middleware"
 	"dangbamgong-backend/internal/push"
 	"dangbamgong-backend/internal/repository"
 	"dangbamgong-backend/internal/service"
+	"dangbamgong-backend/internal/storage"
 )
 
 type Server struct {
 	port         int
+	blobStorage  storage.BlobStorage
+	sessions     service.SessionService
+	rateLimits   middleware.RateLimitStore
 	health       *handler.HealthHandler
 	auth         *handler.AuthHandler
 	activity     *handler.ActivityHandler
 	user         *handler.UserHandler
 	void         *handler.VoidHandler
+	voidRoom     *handler.VoidRoomHandler
 	friend       *handler.FriendHandler
+	circle       *handler.CircleHandler
+	feed         *handler.FeedHandler
 	stat         *handler.StatHandler
 	notification *handler.NotificationHandler
 	device       *handler.DeviceHandler
+	export       *handler.DataExportHandler
 }
 
 func NewServer() *http.Server {
 	port, _ := strconv.Atoi(os.Getenv("PORT"))
 
+	if err := auth.LoadKeys(); err != nil {
+		log.Fatalf("failed to load jwt keys: %v", err)
+	}
+
 	db := database.New()
 
 	socialVerifier := auth.NewSocialVerifier()
 	pushClient := push.NewAPNsClient()
+	nicknameFilter := filter.NewNicknameFilter()
+	blobStorage, err := storage.NewBlobStorage()
+	if err != nil {
+		log.Fatalf("failed to set up storage: %v", err)
+	}
+	privateStorage, err := storage.NewPrivateStorage()
+	if err != nil {
+		log.Fatalf("failed to set up private storage: %v", err)
+	}
 
 	healthRepo := repository.NewHealthRepository(db)
 	userRepo := repository.NewUserRepository(db)
+	identityRepo := repository.NewIdentityRepository(db)
 	activityRepo := repository.NewActivityRepository(db)
 	blockRepo := repository.NewBlockRepository(db)
 	friendshipRepo := repository.NewFriendshipRepository(db)
@@ -49,40 +74,125 @@ func NewServer() *http.Server {
 	deviceTokenRepo := repository.NewDeviceTokenRepository(db)
 	voidSessionRepo := repository.NewVoidSessionRepository(db)
 	statRepo := repository.NewStatRepository(db)
+	nicknameHistoryRepo := repository.NewNicknameHistoryRepository(db)
+	tagReservationRepo := repository.NewTagReservationRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	sessionRepo := repository.NewSessionRepository(db)
+	dataExportRepo := repository.NewDataExportRepository(db)
+	nudgeRepo := repository.NewNudgeRepository(db)
+	friendInviteRepo := repository.NewFriendInviteRepository(db)
+	circleRepo := repository.NewCircleRepository(db)
+	dismissalRepo := repository.NewSuggestionDismissalRepository(db)
+	feedEventRepo := repository.NewFeedEventRepository(db)
+	reactionRepo := repository.NewSessionReactionRepository(db)
+	voidRoomRepo := repository.NewVoidRoomRepository(db)
+
+	// 인스턴스가 여러 대면 RATE_LIMIT_BACKEND=mongo 로 제한 횟수를 공유
+	var rateLimits middleware.RateLimitStore = middleware.NewMemoryRateLimitStore()
+	if os.Getenv("RATE_LIMIT_BACKEND") == "mongo" {
+		rateLimits = repository.NewRateLimitRepository(db)
+	}
 
 	healthSvc := service.NewHealthService(healthRepo)
-	authSvc := service.NewAuthService(userRepo, socialVerifier)
-	activitySvc := service.NewActivityService(activityRepo)
-	userSvc := service.NewUserService(userRepo, blockRepo, friendshipRepo, friendRequestRepo)
+	sessionSvc := service.NewSessionService(sessionRepo, refreshTokenRepo, userRepo)
 	notifSvc := service.NewNotificationService(notifRepo, deviceTokenRepo, userRepo, pushClient)
 	reminderScheduler := service.NewVoidReminderScheduler(notifSvc, userRepo)
-	voidSvc := service.NewVoidService(userRepo, voidSessionRepo, activityRepo, reminderScheduler)
-	friendSvc := service.NewFriendService(userRepo, blockRepo, friendshipRepo, friendRequestRepo, notifSvc)
+	friendRequestExpiry := service.NewFriendRequestExpiry(friendRequestRepo, userRepo, notifSvc)
+	reactionNotifier := service.NewSessionReactionNotifier(reactionRepo, userRepo, notifSvc)
+	accountDeletion := service.NewAccountDeletion(service.AccountDeletionRepositories{
+		User:            userRepo,
+		Identity:        identityRepo,
+		Session:         sessionRepo,
+		RefreshToken:    refreshTokenRepo,
+		DeviceToken:     deviceTokenRepo,
+		Notification:    notifRepo,
+		Activity:        activityRepo,
+		Friendship:      friendshipRepo,
+		FriendRequest:   friendRequestRepo,
+		Block:           blockRepo,
+		NicknameHistory: nicknameHistoryRepo,
+		TagReservation:  tagReservationRepo,
+		VoidSession:     voidSessionRepo,
+		Stat:            statRepo,
+		DataExport:      dataExportRepo,
+		Nudge:           nudgeRepo,
+		FriendInvite:    friendInviteRepo,
+		Circle:          circleRepo,
+		Dismissal:       dismissalRepo,
+		FeedEvent:       feedEventRepo,
+		SessionReaction: reactionRepo,
+		VoidRoom:        voidRoomRepo,
+	}, socialVerifier, blobStorage, privateStorage, reminderScheduler)
+	authSvc := service.NewAuthService(userRepo, identityRepo, tagReservationRepo, refreshTokenRepo, sessionRepo, sessionSvc, accountDeletion, socialVerifier, nicknameFilter)
+	activitySvc := service.NewActivityService(activityRepo)
+	userSvc := service.NewUserService(userRepo, blockRepo, friendshipRepo, friendRequestRepo, voidSessionRepo, nicknameHistoryRepo, tagReservationRepo, circleRepo, nicknameFilter, blobStorage)
+	feedSvc := service.NewFeedService(feedEventRepo, userRepo, friendshipRepo, blockRepo, circleRepo, voidSessionRepo, reactionRepo, blobStorage)
+	voidSvc := service.NewVoidService(userRepo, voidSessionRepo, activityRepo, friendshipRepo, blockRepo, circleRepo, reactionRepo, voidRoomRepo, feedSvc, reminderScheduler)
+	friendSvc := service.NewFriendService(userRepo, blockRepo, friendshipRepo, friendRequestRepo, nicknameHistoryRepo, nudgeRepo, friendInviteRepo, circleRepo, dismissalRepo, notifSvc, blobStorage)
+	voidRoomSvc := service.NewVoidRoomService(voidRoomRepo, userRepo, friendshipRepo, blockRepo, voidSessionRepo, notifSvc, blobStorage)
+	circleSvc := service.NewCircleService(circleRepo, friendshipRepo, userRepo, blobStorage)
 	statSvc := service.NewStatService(statRepo, voidSessionRepo)
+	exportSvc := service.NewDataExportService(service.DataExportRepositories{
+		DataExport:      dataExportRepo,
+		User:            userRepo,
+		Identity:        identityRepo,
+		Activity:        activityRepo,
+		VoidSession:     voidSessionRepo,
+		Friendship:      friendshipRepo,
+		FriendRequest:   friendRequestRepo,
+		Block:           blockRepo,
+		Notification:    notifRepo,
+		DeviceToken:     deviceTokenRepo,
+		Nudge:           nudgeRepo,
+		Circle:          circleRepo,
+		Dismissal:       dismissalRepo,
+		FeedEvent:       feedEventRepo,
+		SessionReaction: reactionRepo,
+		VoidRoom:        voidRoomRepo,
+	}, privateStorage)
 
 	healthHandler := handler.NewHealthHandler(healthSvc)
-	authHandler := handler.NewAuthHandler(authSvc)
+	authHandler := handler.NewAuthHandler(authSvc, sessionSvc)
 	activityHandler := handler.NewActivityHandler(activitySvc)
 	userHandler := handler.NewUserHandler(userSvc)
 	voidHandler := handler.NewVoidHandler(voidSvc)
+	voidRoomHandler := handler.NewVoidRoomHandler(voidRoomSvc)
 	friendHandler := handler.NewFriendHandler(friendSvc)
+	circleHandler := handler.NewCircleHandler(circleSvc)
+	feedHandler := handler.NewFeedHandler(feedSvc)
 	statHandler := handler.NewStatHandler(statSvc)
 	notificationHandler := handler.NewNotificationHandler(notifSvc)
 	deviceHandler := handler.NewDeviceHandler(deviceTokenRepo)
+	exportHandler := handler.NewDataExportHandler(exportSvc)
 
+	authSvc.MigrateLegacyIdentities(context.Background())
 	reminderScheduler.RecoverAll(context.Background())
+	nicknameFilter.Watch(context.Background(), time.Minute)
+	accountDeletion.Watch(context.Background())
+	friendRequestExpiry.Watch(context.Background())
+	reactionNotifier.Watch(context.Background())
+	exportSvc.RecoverPending(context.Background())
+	exportSvc.Watch(context.Background())
+	voidRoomSvc.Watch(context.Background())
 
 	s := &Server{
 		port:         port,
+		blobStorage:  blobStorage,
+		sessions:     sessionSvc,
+		rateLimits:   rateLimits,
 		health:       healthHandler,
 		auth:         authHandler,
 		activity:     activityHandler,
 		user:         userHandler,
 		void:         voidHandler,
+		voidRoom:     voidRoomHandler,
 		friend:       friendHandler,
+		circle:       circleHandler,
+		feed:         feedHandler,
 		stat:         statHandler,
 		notification: notificationHandler,
 		device:       deviceHandler,
+		export:       exportHandler,
 	}
 
 	server := &http.Server{
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"dangbamgong-backend/internal/auth"
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+	"dangbamgong-backend/internal/model"
+	"dangbamgong-backend/internal/repository"
+	"dangbamgong-backend/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const accountPurgeBatchSize = 100
+
+// AccountDeletion 은 탈퇴 유예와 유예 기간이 지난 계정의 완전 삭제를 담당한다.
+// 탈퇴를 요청하면 계정은 즉시 모든 조회와 전체 통계에서 빠지고,
+// WITHDRAWAL_GRACE_PERIOD(기본 30일) 안에 다시 로그인하면 그대로 복구된다.
+type AccountDeletion struct {
+	userRepo            repository.UserRepository
+	identityRepo        repository.IdentityRepository
+	sessionRepo         repository.SessionRepository
+	refreshTokenRepo    repository.RefreshTokenRepository
+	deviceTokenRepo     repository.DeviceTokenRepository
+	notifRepo           repository.NotificationRepository
+	activityRepo        repository.ActivityRepository
+	friendshipRepo      repository.FriendshipRepository
+	friendRequestRepo   repository.FriendRequestRepository
+	blockRepo           repository.BlockRepository
+	nicknameHistoryRepo repository.NicknameHistoryRepository
+	tagReservationRepo  repository.TagReservationRepository
+	voidSessionRepo     repository.VoidSessionRepository
+	statRepo            repository.StatRepository
+	dataExportRepo      repository.DataExportRepository
+	nudgeRepo           repository.NudgeRepository
+	friendInviteRepo    repository.FriendInviteRepository
+	circleRepo          repository.CircleRepository
+	dismissalRepo       repository.SuggestionDismissalRepository
+	feedEventRepo       repository.FeedEventRepository
+	reactionRepo        repository.SessionReactionRepository
+	voidRoomRepo        repository.VoidRoomRepository
+	socialVerifier      auth.SocialVerifier
+	blobStorage         storage.BlobStorage
+	privateStorage      storage.BlobStorage
+	reminderScheduler   *VoidReminderScheduler
+	gracePeriod         time.Duration
+}
+
+// AccountDeletionRepositories 는 탈퇴한 유저의 문서를 지울 저장소 목록이다.
+// 같은 타입이 많아 위치로 넘기면 순서가 바뀌어도 컴파일되므로 이름으로 넘긴다.
+type AccountDeletionRepositories struct {
+	User            repository.UserRepository
+	Identity        repository.IdentityRepository
+	Session         repository.SessionRepository
+	RefreshToken    repository.RefreshTokenRepository
+	DeviceToken     repository.DeviceTokenRepository
+	Notification    repository.NotificationRepository
+	Activity        repository.ActivityRepository
+	Friendship      repository.FriendshipRepository
+	FriendRequest   repository.FriendRequestRepository
+	Block           repository.BlockRepository
+	NicknameHistory repository.NicknameHistoryRepository
+	TagReservation  repository.TagReservationRepository
+	VoidSession     repository.VoidSessionRepository
+	Stat            repository.StatRepository
+	DataExport      repository.DataExportRepository
+	Nudge           repository.NudgeRepository
+	FriendInvite    repository.FriendInviteRepository
+	Circle          repository.CircleRepository
+	Dismissal       repository.SuggestionDismissalRepository
+	FeedEvent       repository.FeedEventRepository
+	SessionReaction repository.SessionReactionRepository
+	VoidRoom        repository.VoidRoomRepository
+}
+
+func NewAccountDeletion(
+	repos AccountDeletionRepositories,
+	socialVerifier auth.SocialVerifier,
+	bs storage.BlobStorage,
+	ps storage.BlobStorage,
+	reminderScheduler *VoidReminderScheduler,
+) *AccountDeletion {
+	return &AccountDeletion{
+		userRepo:            repos.User,
+		identityRepo:        repos.Identity,
+		sessionRepo:         repos.Session,
+		refreshTokenRepo:    repos.RefreshToken,
+		deviceTokenRepo:     repos.DeviceToken,
+		notifRepo:           repos.Notification,
+		activityRepo:        repos.Activity,
+		friendshipRepo:      repos.Friendship,
+		friendRequestRepo:   repos.FriendRequest,
+		blockRepo:           repos.Block,
+		nicknameHistoryRepo: repos.NicknameHistory,
+		tagReservationRepo:  repos.TagReservation,
+		voidSessionRepo:     repos.VoidSession,
+		statRepo:            repos.Stat,
+		dataExportRepo:      repos.DataExport,
+		nudgeRepo:           repos.Nudge,
+		friendInviteRepo:    repos.FriendInvite,
+		circleRepo:          repos.Circle,
+		dismissalRepo:       repos.Dismissal,
+		feedEventRepo:       repos.FeedEvent,
+		reactionRepo:        repos.SessionReaction,
+		voidRoomRepo:        repos.VoidRoom,
+		socialVerifier:      socialVerifier,
+		blobStorage:         bs,
+		privateStorage:      ps,
+		reminderScheduler:   reminderScheduler,
+		gracePeriod:         config.GetDuration("WITHDRAWAL_GRACE_PERIOD", 30*24*time.Hour),
+	}
+}
+
+// DeleteAt 은 탈퇴 요청한 계정이 완전히 삭제되는 시각이다.
+func (d *AccountDeletion) DeleteAt(requestedAt time.Time) time.Time {
+	return requestedAt.Add(d.gracePeriod)
+}
+
+// Expired 는 유예 기간이 지나 더 이상 복구할 수 없는 계정인지 확인한다.
+func (d *AccountDeletion) Expired(user *model.User, now time.Time) bool {
+	return user.DeletionRequestedAt != nil && !now.Before(d.DeleteAt(*user.DeletionRequestedAt))
+}
+
+// Request 는 탈퇴 유예를 시작한다. 세션 폐기는 호출하는 쪽에서 처리한다.
+func (d *AccountDeletion) Request(ctx context.Context, user *model.User, now time.Time) error {
+	if err := d.userRepo.MarkDeletionRequested(ctx, user.ID, now); err != nil {
+		return domain.NewInternal("failed to mark deletion: " + err.Error())
+	}
+	d.reminderScheduler.Cancel(user.ID.Hex())
+
+	if err := d.setSessionsHidden(ctx, user.ID, true); err != nil {
+		return domain.NewInternal("failed to hide void sessions: " + err.Error())
+	}
+
+	// 마지막 멤버였으면 방을 닫음. 세션을 숨긴 뒤에 닫으므로 합계에서도 빠짐
+	room, err := d.voidRoomRepo.FindOpenByMemberID(ctx, user.ID)
+	if err != nil {
+		return domain.NewInternal("failed to find void room: " + err.Error())
+	}
+	if room != nil {
+		if _, err := leaveVoidRoom(ctx, d.voidRoomRepo, d.voidSessionRepo, room.ID, user.ID); err != nil {
+			return domain.NewInternal("failed to leave void room: " + err.Error())
+		}
+	}
+
+	log.Printf("[ACCOUNT] deletion requested for user %s, deleting at %s\n", user.ID.Hex(), d.DeleteAt(now).Format(time.RFC3339))
+	return nil
+}
+
+// Restore 는 유예 기간 안에 다시 로그인한 계정을 복구한다.
+func (d *AccountDeletion) Restore(ctx context.Context, user *model.User) error {
+	if err := d.setSessionsHidden(ctx, user.ID, false); err != nil {
+		return domain.NewInternal("failed to restore void sessions: " + err.Error())
+	}
+	if err := d.userRepo.ClearDeletionRequested(ctx, user.ID); err != nil {
+		return domain.NewInternal("failed to restore user: " + err.Error())
+	}
+	user.DeletionRequestedAt = nil
+
+	log.Printf("[ACCOUNT] restored user %s\n", user.ID.Hex())
+	return nil
+}
+
+// setSessionsHidden 은 유저의 밤샘 기록을 전체 통계에서 빼거나 다시 넣고, 영향을 받은 날짜의 버킷 캐시를 지운다.
+func (d *AccountDeletion) setSessionsHidden(ctx context.Context, userID primitive.ObjectID, hidden bool) error {
+	days, err := d.voidSessionRepo.FindTargetDaysByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := d.voidSessionRepo.SetHiddenByUserID(ctx, userID, hidden); err != nil {
+		return err
+	}
+	return d.statRepo.DeleteBucketCache(ctx, days)
+}
+
+// Purge 는 계정과 관련된 모든 문서를 지운다. 중간에 실패하면 유저 문서가 남아 있으므로 다음 실행에서 다시 시도한다.
+func (d *AccountDeletion) Purge(ctx context.Context, user *model.User) error {
+	uid := user.ID
+
+	identities, err := d.identityRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("find identities: %w", err)
+	}
+	for _, identity := range identities {
+		// 탈퇴할 때 이미 폐기했지만, 그때 제공자 장애로 실패했을 수 있으므로 한 번 더 시도.
+		// 제공자 장애로 삭제가 막히지 않도록 실패는 로그만 남김
+		if err := d.socialVerifier.Revoke(ctx, string(identity.Provider), identity.AppleRefreshToken); err != nil {
+			log.Printf("[ACCOUNT] failed to revoke %s token for user %s: %v\n", identity.Provider, uid.Hex(), err)
+		}
+	}
+
+	exports, err := d.dataExportRepo.FindByUserID(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("find data exports: %w", err)
+	}
+	for _, export := range exports {
+		if export.ObjectKey == "" {
+			continue
+		}
+		if err := d.privateStorage.Delete(ctx, export.ObjectKey); err != nil {
+			return fmt.Errorf("delete data export file: %w", err)
+		}
+	}
+
+	days, err := d.voidSessionRepo.FindTargetDaysByUserID(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("find void session days: %w", err)
+	}
+
+	steps := []struct {
+		name string
+		fn   func(context.Context, primitive.ObjectID) error
+	}{
+		{"identities", d.identityRepo.DeleteByUserID},
+		{"sessions", d.sessionRepo.DeleteByUserID},
+		{"refresh tokens", d.refreshTokenRepo.DeleteByUserID},
+		{"device tokens", d.deviceTokenRepo.DeleteByUserID},
+		{"notifications", d.notifRepo.DeleteByUserID},
+		{"activities", d.activityRepo.DeleteByUserID},
+		{"friendships", d.friendshipRepo.DeleteByUserID},
+		{"friend requests", d.friendRequestRepo.DeleteByUserID},
+		{"blocks", d.blockRepo.DeleteByUserID},
+		{"nickname histories", d.nicknameHistoryRepo.DeleteByUserID},
+		{"tag reservations", d.tagReservationRepo.DeleteByUserID},
+		{"void sessions", d.voidSessionRepo.DeleteByUserID},
+		{"data exports", d.dataExportRepo.DeleteByUserID},
+		{"nudges", d.nudgeRepo.DeleteByUserID},
+		{"friend invites", d.friendInviteRepo.DeleteByUserID},
+		{"circles", d.circleRepo.DeleteByUserID},
+		{"suggestion dismissals", d.dismissalRepo.DeleteByUserID},
+		{"feed events", d.feedEventRepo.DeleteByUserID},
+		{"session reactions", d.reactionRepo.DeleteByUserID},
+		{"void rooms", d.voidRoomRepo.DeleteByUserID},
+	}
+	for _, step := range steps {
+		if err := step.fn(ctx, uid); err != nil {
+			return fmt.Errorf("delete %s: %w", step.name, err)
+		}
+	}
+
+	if err := d.statRepo.DeleteBucketCache(ctx, days); err != nil {
+		return fmt.Errorf("delete bucket cache: %w", err)
+	}
+
+	if user.AvatarKey != "" {
+		deleteAvatarObjects(ctx, d.blobStorage, user.AvatarKey)
+	}
+
+	if err := d.userRepo.DeleteByID(ctx, uid); err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+
+	log.Printf("[ACCOUNT] purged user %s\n", uid.Hex())
+	return nil
+}
+
+// PurgeExpired 는 유예 기간이 지난 계정을 모두 삭제하고 삭제한 수를 반환한다.
+func (d *AccountDeletion) PurgeExpired(ctx context.Context) int {
+	purged := 0
+	for {
+		users, err := d.userRepo.FindPendingDeletionBefore(ctx, time.Now().Add(-d.gracePeriod), accountPurgeBatchSize)
+		if err != nil {
+			log.Printf("[ACCOUNT] failed to find expired accounts: %v\n", err)
+			return purged
+		}
+
+		failed := 0
+		for i := range users {
+			if err := d.Purge(ctx, &users[i]); err != nil {
+				log.Printf("[ACCOUNT] failed to purge user %s: %v\n", users[i].ID.Hex(), err)
+				failed++
+				continue
+			}
+			purged++
+		}
+
+		// 실패한 계정만 남았으면 다음 실행에서 다시 시도
+		if len(users) < accountPurgeBatchSize || failed == len(users) {
+			return purged
+		}
+	}
+}
+
+// Watch 는 ACCOUNT_PURGE_INTERVAL(기본 1시간)마다 유예 기간이 지난 계정을 삭제한다.
+func (d *AccountDeletion) Watch(ctx context.Context) {
+	interval := config.GetDuration("ACCOUNT_PURGE_INTERVAL", time.Hour)
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if n := d.PurgeExpired(ctx); n > 0 {
+				log.Printf("[ACCOUNT] purged %d expired accounts\n", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -3,13 +3,15 @@ package service
 import (
 	"context"
 	"crypto/rand"
+	"log"
 	"math/big"
 	"time"
-	"unicode/utf8"
 
 	"dangbamgong-backend/internal/auth"
+	"dangbamgong-backend/internal/config"
 	"dangbamgong-backend/internal/domain"
 	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/filter"
 	"dangbamgong-backend/internal/model"
 	"dangbamgong-backend/internal/repository"
 
@@ -20,24 +22,55 @@ import (
 type AuthService interface {
 	Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error)
 	TestLogin(ctx context.Context, req dto.TestLoginRequest) (*dto.LoginResponse, error)
+	Refresh(ctx context.Context, req dto.RefreshRequest) (*dto.RefreshResponse, error)
 	SetNickname(ctx context.Context, userID string, req dto.SetNicknameRequest) (*dto.SetNicknameResponse, error)
-	Withdraw(ctx context.Context, userID string) error
+	Withdraw(ctx context.Context, userID string) (*dto.WithdrawResponse, error)
+	GetIdentities(ctx context.Context, userID string) (*dto.IdentityListResponse, error)
+	LinkIdentity(ctx context.Context, userID string, req dto.LinkIdentityRequest) (*dto.IdentityListResponse, error)
+	UnlinkIdentity(ctx context.Context, userID string, provider string) error
+	MigrateLegacyIdentities(ctx context.Context)
 }
 
 type authService struct {
-	userRepo       repository.UserRepository
-	socialVerifier auth.SocialVerifier
+	userRepo           repository.UserRepository
+	identityRepo       repository.IdentityRepository
+	tagReservationRepo repository.TagReservationRepository
+	refreshTokenRepo   repository.RefreshTokenRepository
+	sessionRepo        repository.SessionRepository
+	sessionSvc         SessionService
+	accountDeletion    *AccountDeletion
+	socialVerifier     auth.SocialVerifier
+	nicknameFilter     *filter.NicknameFilter
+	refreshTokenTTL    time.Duration
 }
 
-func NewAuthService(ur repository.UserRepository, socialVerifier auth.SocialVerifier) AuthService {
+func NewAuthService(
+	ur repository.UserRepository,
+	ir repository.IdentityRepository,
+	trr repository.TagReservationRepository,
+	rtr repository.RefreshTokenRepository,
+	sr repository.SessionRepository,
+	ss SessionService,
+	ad *AccountDeletion,
+	socialVerifier auth.SocialVerifier,
+	nf *filter.NicknameFilter,
+) AuthService {
 	return &authService{
-		userRepo:       ur,
-		socialVerifier: socialVerifier,
+		userRepo:           ur,
+		identityRepo:       ir,
+		tagReservationRepo: trr,
+		refreshTokenRepo:   rtr,
+		sessionRepo:        sr,
+		sessionSvc:         ss,
+		accountDeletion:    ad,
+		socialVerifier:     socialVerifier,
+		nicknameFilter:     nf,
+		refreshTokenTTL:    config.GetDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour),
 	}
 }
 
 func (s *authService) Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error) {
-	result, err := s.socialVerifier.Verify(ctx, req.Provider, req.IDToken)
+	result, err := s.socialVerifier.Verify(ctx, req.Provider, req.IDToken, req.Nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -47,11 +80,11 @@ func (s *authService) Login(ctx context.Context, req dto.LoginRequest) (*dto.Log
 		appleRefresh = *req.AppleRefreshToken
 	}
 
-	return s.findOrCreateAndGenerateToken(ctx, model.SocialProvider(req.Provider), result.SocialID, appleRefresh)
+	return s.findOrCreateAndGenerateToken(ctx, model.SocialProvider(req.Provider), result.SocialID, appleRefresh, req.Device)
 }
 
 func (s *authService) TestLogin(ctx context.Context, req dto.TestLoginRequest) (*dto.LoginResponse, error) {
-	return s.findOrCreateAndGenerateToken(ctx, model.ProviderTest, req.SocialID, "")
+	return s.findOrCreateAndGenerateToken(ctx, model.ProviderTest, req.SocialID, "", req.Device)
 }
 
 func (s *authService) findOrCreateAndGenerateToken(
@@ -59,49 +92,237 @@ func (s *authService) findOrCreateAndGenerateToken(
 	provider model.SocialProvider,
 	socialID string,
 	appleRefreshToken string,
+	device dto.DeviceInfo,
 ) (*dto.LoginResponse, error) {
-	user, err := s.userRepo.FindBySocial(ctx, provider, socialID)
+	user, isNewUser, err := s.findOrCreateUser(ctx, provider, socialID, appleRefreshToken)
 	if err != nil {
-		return nil, domain.NewInternal("failed to find user: " + err.Error())
+		return nil, err
+	}
+	if user.SuspendedAt != nil {
+		return nil, domain.NewForbidden(domain.ErrAccountSuspended, "account suspended")
 	}
 
-	isNewUser := false
-	if user == nil {
-		isNewUser = true
-		now := time.Now()
-		user = &model.User{
-			SocialProvider: provider,
-			SocialID:       socialID,
-			NotificationSettings: model.NotificationSettings{
-				VoidReminder:  true,
-				ReminderHours: 1,
-				FriendRequest: true,
-				FriendNudge:   true,
-			},
-			AppleRefreshToken: appleRefreshToken,
-			CreatedAt:         now,
-			UpdatedAt:         now,
-		}
-		if err := s.createUserWithUniqueTag(ctx, user); err != nil {
+	// 탈퇴 유예 중에 로그인하면 계정 복구
+	restored := false
+	if user.DeletionRequestedAt != nil {
+		if err := s.accountDeletion.Restore(ctx, user); err != nil {
 			return nil, err
 		}
+		restored = true
 	}
 
-	token, err := auth.GenerateToken(user.ID.Hex())
+	now := time.Now()
+	session := &model.Session{
+		UserID:     user.ID,
+		Provider:   provider,
+		DeviceName: device.Name,
+		Platform:   device.Platform,
+		AppVersion: device.AppVersion,
+		UserAgent:  device.UserAgent,
+		IP:         device.IP,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return nil, domain.NewInternal("failed to create session: " + err.Error())
+	}
+
+	accessToken, refreshToken, err := s.issueTokens(ctx, user.ID, session.ID)
 	if err != nil {
-		return nil, domain.NewInternal("failed to generate token: " + err.Error())
+		return nil, err
 	}
 
 	return &dto.LoginResponse{
-		AccessToken: token,
-		IsNewUser:   isNewUser,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		IsNewUser:    isNewUser,
+		IsRestored:   restored,
+	}, nil
+}
+
+// findOrCreateUser 는 연결된 소셜 계정으로 유저를 찾고, 처음 보는 계정이면 새 유저를 만든다.
+func (s *authService) findOrCreateUser(
+	ctx context.Context,
+	provider model.SocialProvider,
+	socialID string,
+	appleRefreshToken string,
+) (*model.User, bool, error) {
+	identity, err := s.identityRepo.FindBySocial(ctx, provider, socialID)
+	if err != nil {
+		return nil, false, domain.NewInternal("failed to find identity: " + err.Error())
+	}
+
+	if identity != nil {
+		user, err := s.userRepo.FindByIDIncludingPendingDeletion(ctx, identity.UserID)
+		if err != nil {
+			return nil, false, domain.NewInternal("failed to find user: " + err.Error())
+		}
+		if user != nil && s.accountDeletion.Expired(user, time.Now()) {
+			// 유예 기간이 지났지만 아직 삭제되지 않은 계정은 지금 삭제하고 새로 가입
+			if err := s.accountDeletion.Purge(ctx, user); err != nil {
+				return nil, false, domain.NewInternal("failed to purge user: " + err.Error())
+			}
+		} else if user != nil {
+			// 탈퇴·연결 해제 시 revoke 할 수 있도록 최신 Apple refresh token 유지
+			if err := s.identityRepo.Touch(ctx, identity.ID, time.Now(), appleRefreshToken); err != nil {
+				return nil, false, domain.NewInternal("failed to update identity: " + err.Error())
+			}
+			return user, false, nil
+		} else if err := s.identityRepo.Delete(ctx, identity.ID); err != nil {
+			// 유저 없이 남은 연결은 정리하고 새로 가입
+			return nil, false, domain.NewInternal("failed to delete identity: " + err.Error())
+		}
+	}
+
+	now := time.Now()
+	user := &model.User{
+		NotificationSettings: model.NotificationSettings{
+			VoidReminder:  true,
+			ReminderHours: 1,
+			FriendRequest: true,
+			FriendNudge:   true,
+		},
+		PrivacySettings: model.PrivacySettings{
+			StatsVisibility: model.StatsVisibilityFriends,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.createUserWithUniqueTag(ctx, user); err != nil {
+		return nil, false, err
+	}
+
+	err = s.identityRepo.Create(ctx, &model.Identity{
+		UserID:            user.ID,
+		Provider:          provider,
+		SocialID:          socialID,
+		AppleRefreshToken: appleRefreshToken,
+		CreatedAt:         now,
+		LastUsedAt:        &now,
+	})
+	if err == nil {
+		return user, true, nil
+	}
+
+	// 같은 계정으로 동시에 첫 로그인한 경우 먼저 연결된 유저를 사용
+	if delErr := s.userRepo.DeleteByID(ctx, user.ID); delErr != nil {
+		log.Printf("[AUTH] failed to delete orphan user %s: %v\n", user.ID.Hex(), delErr)
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, false, domain.NewInternal("failed to create identity: " + err.Error())
+	}
+	identity, err = s.identityRepo.FindBySocial(ctx, provider, socialID)
+	if err != nil || identity == nil {
+		return nil, false, domain.NewInternal("failed to find identity after conflict")
+	}
+	existing, err := s.userRepo.FindByID(ctx, identity.UserID)
+	if err != nil || existing == nil {
+		return nil, false, domain.NewInternal("failed to find user after conflict")
+	}
+	return existing, false, nil
+}
+
+// Refresh 는 refresh token 을 새 토큰 쌍으로 교환한다.
+// 이미 사용한 토큰이 다시 들어오면 탈취로 보고 같은 세션(family)의 토큰을 모두 폐기한다.
+func (s *authService) Refresh(ctx context.Context, req dto.RefreshRequest) (*dto.RefreshResponse, error) {
+	token, err := s.refreshTokenRepo.FindByHash(ctx, auth.HashRefreshToken(req.RefreshToken))
+	if err != nil {
+		return nil, domain.NewInternal("failed to find refresh token: " + err.Error())
+	}
+	if token == nil || token.RevokedAt != nil {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "invalid refresh token")
+	}
+
+	now := time.Now()
+	if token.UsedAt != nil {
+		return nil, s.revokeReusedFamily(ctx, token)
+	}
+	if !now.Before(token.ExpiresAt) {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "refresh token expired")
+	}
+
+	marked, err := s.refreshTokenRepo.MarkUsed(ctx, token.ID, now)
+	if err != nil {
+		return nil, domain.NewInternal("failed to update refresh token: " + err.Error())
+	}
+	if !marked {
+		// 동시에 같은 토큰으로 요청한 경우
+		return nil, s.revokeReusedFamily(ctx, token)
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, token.FamilyID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find session: " + err.Error())
+	}
+	if session == nil || session.RevokedAt != nil {
+		return nil, domain.NewUnauthorized(domain.ErrInvalidToken, "session revoked")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find user: " + err.Error())
+	}
+	if user == nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+	if user.SuspendedAt != nil {
+		return nil, domain.NewForbidden(domain.ErrAccountSuspended, "account suspended")
+	}
+
+	if err := s.sessionRepo.Touch(ctx, session.ID, now); err != nil {
+		return nil, domain.NewInternal("failed to update session: " + err.Error())
+	}
+
+	accessToken, refreshToken, err := s.issueTokens(ctx, user.ID, token.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.RefreshResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
 	}, nil
 }
 
+// revokeReusedFamily 는 탈취가 의심되는 세션을 통째로 폐기한다. family ID 가 세션 ID 이므로
+// refresh token 뿐 아니라 이미 발급된 액세스 토큰도 바로 막힌다.
+func (s *authService) revokeReusedFamily(ctx context.Context, token *model.RefreshToken) error {
+	log.Printf("[AUTH] refresh token reuse detected for user %s, revoking session %s\n", token.UserID.Hex(), token.FamilyID.Hex())
+	if err := s.sessionSvc.Revoke(ctx, token.FamilyID); err != nil {
+		return err
+	}
+	return domain.NewUnauthorized(domain.ErrRefreshTokenReused, "refresh token already used")
+}
+
+// issueTokens 는 세션의 액세스 토큰과 새 refresh token 을 발급한다. 세션 ID 가 refresh token family 이다.
+func (s *authService) issueTokens(ctx context.Context, userID primitive.ObjectID, sessionID primitive.ObjectID) (string, string, error) {
+	accessToken, err := auth.GenerateToken(userID.Hex(), sessionID.Hex())
+	if err != nil {
+		return "", "", domain.NewInternal("failed to generate token: " + err.Error())
+	}
+
+	refreshToken, hash, err := auth.GenerateRefreshToken()
+	if err != nil {
+		return "", "", domain.NewInternal("failed to generate refresh token: " + err.Error())
+	}
+
+	now := time.Now()
+	if err := s.refreshTokenRepo.Create(ctx, &model.RefreshToken{
+		UserID:    userID,
+		FamilyID:  sessionID,
+		TokenHash: hash,
+		ExpiresAt: now.Add(s.refreshTokenTTL),
+		CreatedAt: now,
+	}); err != nil {
+		return "", "", domain.NewInternal("failed to create refresh token: " + err.Error())
+	}
+
+	return accessToken, refreshToken, nil
+}
+
 func (s *authService) SetNickname(ctx context.Context, userID string, req dto.SetNicknameRequest) (*dto.SetNicknameResponse, error) {
-	length := utf8.RuneCountInString(req.Nickname)
-	if length < 3 || length > 15 {
-		return nil, domain.NewBadRequest(domain.ErrInvalidNickname, "nickname must be 3-15 characters")
+	if err := validateNickname(s.nicknameFilter, req.Nickname); err != nil {
+		return nil, err
 	}
 
 	oid, err := primitive.ObjectIDFromHex(userID)
@@ -125,27 +346,203 @@ func (s *authService) SetNickname(ctx context.Context, userID string, req dto.Se
 	return &dto.SetNicknameResponse{Nickname: req.Nickname}, nil
 }
 
-func (s *authService) Withdraw(ctx context.Context, userID string) error {
+// Withdraw 는 탈퇴 유예를 시작하고 모든 세션을 종료한다.
+// 유예 기간 안에 다시 로그인하면 복구되고, 기간이 지나면 관련 데이터와 함께 완전히 삭제된다.
+func (s *authService) Withdraw(ctx context.Context, userID string) (*dto.WithdrawResponse, error) {
 	oid, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
-		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
 	}
 
 	user, err := s.userRepo.FindByID(ctx, oid)
 	if err != nil || user == nil {
-		return domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
 	}
 
-	// TODO: revoke social account (Apple uses apple_refresh_token)
-	// TODO: delete related data (activities, friends, void sessions, etc.)
+	if err := s.sessionSvc.RevokeAll(ctx, oid); err != nil {
+		return nil, err
+	}
 
-	if err := s.userRepo.DeleteByID(ctx, oid); err != nil {
-		return domain.NewInternal("failed to delete user: " + err.Error())
+	// App Store 심사 기준에 따라 Apple 토큰은 유예 기간을 기다리지 않고 탈퇴 즉시 폐기.
+	// 복구할 때는 다시 로그인하면서 새로 동의를 받음
+	identities, err := s.identityRepo.FindByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find identities: " + err.Error())
+	}
+	for _, identity := range identities {
+		s.revokeIdentity(ctx, identity)
+	}
+
+	now := time.Now()
+	if err := s.accountDeletion.Request(ctx, user, now); err != nil {
+		return nil, err
+	}
+
+	return &dto.WithdrawResponse{DeleteAt: s.accountDeletion.DeleteAt(now)}, nil
+}
+
+func (s *authService) GetIdentities(ctx context.Context, userID string) (*dto.IdentityListResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	identities, err := s.identityRepo.FindByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find identities: " + err.Error())
+	}
+
+	items := make([]dto.IdentityItem, len(identities))
+	for i, identity := range identities {
+		items[i] = dto.IdentityItem{
+			Provider:   string(identity.Provider),
+			LinkedAt:   identity.CreatedAt,
+			LastUsedAt: identity.LastUsedAt,
+		}
+	}
+	return &dto.IdentityListResponse{Identities: items}, nil
+}
+
+// LinkIdentity 는 로그인한 유저에 다른 소셜 계정을 연결한다. 제공자별로 하나만 연결할 수 있다.
+// 미리 확인하지만 동시에 들어온 요청은 identities 의 유니크 인덱스로 막는다.
+func (s *authService) LinkIdentity(ctx context.Context, userID string, req dto.LinkIdentityRequest) (*dto.IdentityListResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	result, err := s.socialVerifier.Verify(ctx, req.Provider, req.IDToken, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	provider := model.SocialProvider(req.Provider)
+
+	existing, err := s.identityRepo.FindBySocial(ctx, provider, result.SocialID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find identity: " + err.Error())
+	}
+	if existing != nil {
+		if existing.UserID != oid {
+			return nil, domain.NewConflict(domain.ErrIdentityLinked, "social account is linked to another user")
+		}
+		// 이미 연결된 계정
+		return s.GetIdentities(ctx, userID)
 	}
 
+	identities, err := s.identityRepo.FindByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find identities: " + err.Error())
+	}
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			return nil, domain.NewConflict(domain.ErrProviderLinked, "provider already linked")
+		}
+	}
+
+	var appleRefresh string
+	if req.AppleRefreshToken != nil {
+		appleRefresh = *req.AppleRefreshToken
+	}
+	err = s.identityRepo.Create(ctx, &model.Identity{
+		UserID:            oid,
+		Provider:          provider,
+		SocialID:          result.SocialID,
+		AppleRefreshToken: appleRefresh,
+		CreatedAt:         time.Now(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, s.linkConflict(ctx, oid, provider, result.SocialID)
+	}
+	if err != nil {
+		return nil, domain.NewInternal("failed to create identity: " + err.Error())
+	}
+
+	return s.GetIdentities(ctx, userID)
+}
+
+// linkConflict 는 동시에 들어온 연결 요청이 유니크 인덱스에 걸렸을 때 어느 쪽이 먼저 연결됐는지 보고 에러를 고른다.
+// (provider, social_id) 가 겹치면 다른 유저에 연결된 계정이고, 아니면 (user_id, provider) 가 겹친 것이다.
+func (s *authService) linkConflict(ctx context.Context, userID primitive.ObjectID, provider model.SocialProvider, socialID string) error {
+	existing, err := s.identityRepo.FindBySocial(ctx, provider, socialID)
+	if err != nil {
+		return domain.NewInternal("failed to find identity: " + err.Error())
+	}
+	if existing != nil && existing.UserID != userID {
+		return domain.NewConflict(domain.ErrIdentityLinked, "social account is linked to another user")
+	}
+	return domain.NewConflict(domain.ErrProviderLinked, "provider already linked")
+}
+
+// UnlinkIdentity 는 소셜 계정 연결을 해제한다. 로그인할 수단이 없어지므로 마지막 계정은 해제할 수 없다.
+func (s *authService) UnlinkIdentity(ctx context.Context, userID string, provider string) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	identities, err := s.identityRepo.FindByUserID(ctx, oid)
+	if err != nil {
+		return domain.NewInternal("failed to find identities: " + err.Error())
+	}
+
+	var target *model.Identity
+	for i := range identities {
+		if string(identities[i].Provider) == provider {
+			target = &identities[i]
+			break
+		}
+	}
+	if target == nil {
+		return domain.NewNotFound(domain.ErrIdentityNotFound, "identity not found")
+	}
+	if len(identities) == 1 {
+		return domain.NewConflict(domain.ErrLastIdentity, "cannot unlink the last identity")
+	}
+
+	if err := s.identityRepo.Delete(ctx, target.ID); err != nil {
+		return domain.NewInternal("failed to delete identity: " + err.Error())
+	}
+	s.revokeIdentity(ctx, *target)
+
 	return nil
 }
 
+// revokeIdentity 는 제공자 쪽 토큰을 폐기한다. 제공자 장애로 탈퇴·연결 해제가 막히지 않도록 실패는 로그만 남김
+func (s *authService) revokeIdentity(ctx context.Context, identity model.Identity) {
+	if err := s.socialVerifier.Revoke(ctx, string(identity.Provider), identity.AppleRefreshToken); err != nil {
+		log.Printf("[AUTH] failed to revoke %s token for user %s: %v\n", identity.Provider, identity.UserID.Hex(), err)
+	}
+}
+
+// MigrateLegacyIdentities 는 users 문서에 남아 있는 예전 소셜 계정 필드를 identities 컬렉션으로 옮긴다.
+// 서버 시작 시 실행하며, 옮길 문서가 없으면 아무것도 하지 않는다.
+func (s *authService) MigrateLegacyIdentities(ctx context.Context) {
+	legacy, err := s.userRepo.FindLegacyIdentities(ctx)
+	if err != nil {
+		log.Printf("[AUTH] failed to find legacy identities: %v\n", err)
+		return
+	}
+	if len(legacy) == 0 {
+		return
+	}
+
+	migrated := 0
+	for i := range legacy {
+		identity := &legacy[i]
+		if err := s.identityRepo.Create(ctx, identity); err != nil && !mongo.IsDuplicateKeyError(err) {
+			log.Printf("[AUTH] failed to migrate identity of user %s: %v\n", identity.UserID.Hex(), err)
+			continue
+		}
+		if err := s.userRepo.UnsetLegacyIdentity(ctx, identity.UserID); err != nil {
+			log.Printf("[AUTH] failed to unset legacy identity of user %s: %v\n", identity.UserID.Hex(), err)
+			continue
+		}
+		migrated++
+	}
+
+	log.Printf("[AUTH] migrated %d legacy identities\n", migrated)
+}
+
 func (s *authService) createUserWithUniqueTag(ctx context.Context, user *model.User) error {
 	const maxRetries = 5
 	for i := 0; i < maxRetries; i++ {
@@ -153,6 +550,14 @@ func (s *authService) createUserWithUniqueTag(ctx context.Context, user *model.U
 		if err != nil {
 			return domain.NewInternal("failed to generate tag: " + err.Error())
 		}
+		// 태그를 변경한 유저의 예전 태그는 예약 기간 동안 사용하지 않음
+		reservation, err := s.tagReservationRepo.FindActiveByTag(ctx, tag)
+		if err != nil {
+			return domain.NewInternal("failed to find tag reservation: " + err.Error())
+		}
+		if reservation != nil {
+			continue
+		}
 		user.Tag = tag
 		err = s.userRepo.Create(ctx, user)
 		if err == nil {
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/storage"
+)
+
+const (
+	avatarSmallSize = 128
+	avatarLargeSize = 512
+)
+
+var avatarSizes = []int{avatarSmallSize, avatarLargeSize}
+
+func avatarObjectKey(avatarKey string, size int) string {
+	return fmt.Sprintf("%s_%d.jpg", avatarKey, size)
+}
+
+// avatarURLs 는 아바타가 없으면 nil 을 반환한다.
+func avatarURLs(blobStorage storage.BlobStorage, avatarKey string) *dto.AvatarURLs {
+	if avatarKey == "" {
+		return nil
+	}
+	return &dto.AvatarURLs{
+		Small: blobStorage.URL(avatarObjectKey(avatarKey, avatarSmallSize)),
+		Large: blobStorage.URL(avatarObjectKey(avatarKey, avatarLargeSize)),
+	}
+}
+
+// deleteAvatarObjects 는 크기별 아바타 파일을 삭제한다. 실패해도 요청은 실패시키지 않는다.
+func deleteAvatarObjects(ctx context.Context, blobStorage storage.BlobStorage, avatarKey string) {
+	for _, size := range avatarSizes {
+		if err := blobStorage.Delete(ctx, avatarObjectKey(avatarKey, size)); err != nil {
+			log.Printf("[STORAGE] failed to delete avatar %s: %v\n", avatarObjectKey(avatarKey, size), err)
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/model"
+	"dangbamgong-backend/internal/repository"
+	"dangbamgong-backend/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const closeFriendsCircleName = "친한 친구"
+
+type CircleService interface {
+	List(ctx context.Context, userID string) (*dto.CircleListResponse, error)
+	Create(ctx context.Context, userID string, req dto.CreateCircleRequest) (*dto.CircleItem, error)
+	UpdateName(ctx context.Context, userID string, circleID string, name string) error
+	Delete(ctx context.Context, userID string, circleID string) error
+	SetMembers(ctx context.Context, userID string, circleID string, req dto.SetCircleMembersRequest) (*dto.CircleItem, error)
+}
+
+type circleService struct {
+	circleRepo     repository.CircleRepository
+	friendshipRepo repository.FriendshipRepository
+	userRepo       repository.UserRepository
+	blobStorage    storage.BlobStorage
+	maxCircles     int
+}
+
+func NewCircleService(cr repository.CircleRepository, fr repository.FriendshipRepository, ur repository.UserRepository, bs storage.BlobStorage) CircleService {
+	return &circleService{
+		circleRepo:     cr,
+		friendshipRepo: fr,
+		userRepo:       ur,
+		blobStorage:    bs,
+		maxCircles:     config.GetInt("CIRCLE_MAX_COUNT", 10),
+	}
+}
+
+// List 는 친한 친구 서클이 없으면 먼저 만든 뒤 모든 서클을 반환한다.
+func (s *circleService) List(ctx context.Context, userID string) (*dto.CircleListResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	if err := s.circleRepo.EnsureCloseFriends(ctx, oid, closeFriendsCircleName); err != nil {
+		return nil, domain.NewInternal("failed to create close friends circle: " + err.Error())
+	}
+
+	circles, err := s.circleRepo.FindByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find circles: " + err.Error())
+	}
+
+	var memberIDs []primitive.ObjectID
+	for _, c := range circles {
+		memberIDs = append(memberIDs, c.MemberIDs...)
+	}
+	userMap, err := s.findUsers(ctx, memberIDs)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find users: " + err.Error())
+	}
+
+	items := make([]dto.CircleItem, len(circles))
+	for i := range circles {
+		items[i] = s.circleItem(&circles[i], userMap)
+	}
+
+	return &dto.CircleListResponse{Circles: items}, nil
+}
+
+func (s *circleService) Create(ctx context.Context, userID string, req dto.CreateCircleRequest) (*dto.CircleItem, error) {
+	name, err := validateCircleName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	count, err := s.circleRepo.CountByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to count circles: " + err.Error())
+	}
+	if int(count) >= s.maxCircles {
+		return nil, domain.NewBadRequest(domain.ErrTooManyCircles, "too many circles")
+	}
+
+	now := time.Now()
+	circle := &model.Circle{
+		UserID:    oid,
+		Name:      name,
+		MemberIDs: []primitive.ObjectID{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.circleRepo.Create(ctx, circle); err != nil {
+		return nil, domain.NewInternal("failed to create circle: " + err.Error())
+	}
+
+	item := s.circleItem(circle, nil)
+	return &item, nil
+}
+
+func (s *circleService) UpdateName(ctx context.Context, userID string, circleID string, name string) error {
+	name, err := validateCircleName(name)
+	if err != nil {
+		return err
+	}
+
+	circle, err := s.findOwnedCircle(ctx, userID, circleID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.circleRepo.UpdateName(ctx, circle.ID, name); err != nil {
+		return domain.NewInternal("failed to update circle name: " + err.Error())
+	}
+
+	return nil
+}
+
+// Delete 는 공개 범위 설정에 쓰이고 있는 서클은 지우지 않는다.
+// 지운 서클을 설정에서 빼면 목록이 비어 모든 친구에게 공개될 수 있기 때문이다.
+func (s *circleService) Delete(ctx context.Context, userID string, circleID string) error {
+	circle, err := s.findOwnedCircle(ctx, userID, circleID)
+	if err != nil {
+		return err
+	}
+	if circle.IsCloseFriends {
+		return domain.NewBadRequest(domain.ErrCircleNotDeletable, "close friends circle cannot be deleted")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, circle.UserID)
+	if err != nil || user == nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+	privacy := user.PrivacySettings
+	if containsObjectID(privacy.StatsCircles, circle.ID) ||
+		containsObjectID(privacy.PresenceCircles, circle.ID) ||
+		containsObjectID(privacy.SessionCircles, circle.ID) {
+		return domain.NewConflict(domain.ErrCircleInUse, "circle is used in privacy settings")
+	}
+
+	if err := s.circleRepo.Delete(ctx, circle.ID); err != nil {
+		return domain.NewInternal("failed to delete circle: " + err.Error())
+	}
+
+	return nil
+}
+
+// SetMembers 는 서클 멤버를 통째로 바꾼다. 친구가 아닌 유저는 넣을 수 없다.
+func (s *circleService) SetMembers(ctx context.Context, userID string, circleID string, req dto.SetCircleMembersRequest) (*dto.CircleItem, error) {
+	circle, err := s.findOwnedCircle(ctx, userID, circleID)
+	if err != nil {
+		return nil, err
+	}
+
+	friendships, err := s.friendshipRepo.FindByUserID(ctx, circle.UserID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find friendships: " + err.Error())
+	}
+	friendIDs := make(map[primitive.ObjectID]bool, len(friendships))
+	for _, f := range friendships {
+		friendIDs[f.FriendID] = true
+	}
+
+	memberIDs := make([]primitive.ObjectID, 0, len(req.UserIDs))
+	for _, id := range req.UserIDs {
+		memberID, err := primitive.ObjectIDFromHex(id)
+		if err != nil || !friendIDs[memberID] {
+			return nil, domain.NewBadRequest(domain.ErrCircleMemberNotFriend, "not a friend: "+id)
+		}
+		if !containsObjectID(memberIDs, memberID) {
+			memberIDs = append(memberIDs, memberID)
+		}
+	}
+
+	if err := s.circleRepo.SetMembers(ctx, circle.ID, memberIDs); err != nil {
+		return nil, domain.NewInternal("failed to update circle members: " + err.Error())
+	}
+	circle.MemberIDs = memberIDs
+
+	userMap, err := s.findUsers(ctx, memberIDs)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find users: " + err.Error())
+	}
+
+	item := s.circleItem(circle, userMap)
+	return &item, nil
+}
+
+// findOwnedCircle 은 다른 유저의 서클이면 존재하지 않는 서클로 취급한다.
+func (s *circleService) findOwnedCircle(ctx context.Context, userID string, circleID string) (*model.Circle, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	circleOid, err := primitive.ObjectIDFromHex(circleID)
+	if err != nil {
+		return nil, domain.NewBadRequest(domain.ErrCircleNotFound, "invalid circle id")
+	}
+
+	circle, err := s.circleRepo.FindByID(ctx, circleOid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find circle: " + err.Error())
+	}
+	if circle == nil || circle.UserID != oid {
+		return nil, domain.NewNotFound(domain.ErrCircleNotFound, "circle not found: "+circleID)
+	}
+
+	return circle, nil
+}
+
+func (s *circleService) findUsers(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*model.User, error) {
+	if len(ids) == 0 {
+		return map[primitive.ObjectID]*model.User{}, nil
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	userMap := make(map[primitive.ObjectID]*model.User, len(users))
+	for i := range users {
+		userMap[users[i].ID] = &users[i]
+	}
+	return userMap, nil
+}
+
+func (s *circleService) circleItem(circle *model.Circle, userMap map[primitive.ObjectID]*model.User) dto.CircleItem {
+	members := make([]dto.UserSearchItem, 0, len(circle.MemberIDs))
+	for _, id := range circle.MemberIDs {
+		u, ok := userMap[id]
+		if !ok {
+			continue
+		}
+		members = append(members, dto.UserSearchItem{
+			UserID:   u.ID.Hex(),
+			Nickname: u.Nickname,
+			Tag:      u.Tag,
+			Avatar:   avatarURLs(s.blobStorage, u.AvatarKey),
+		})
+	}
+
+	return dto.CircleItem{
+		ID:             circle.ID.Hex(),
+		Name:           circle.Name,
+		IsCloseFriends: circle.IsCloseFriends,
+		Members:        members,
+		CreatedAt:      circle.CreatedAt,
+	}
+}
+
+func validateCircleName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	length := utf8.RuneCountInString(name)
+	if length < 1 || length > 20 {
+		return "", domain.NewBadRequest(domain.ErrInvalidCircleName, "circle name must be 1-20 characters")
+	}
+	return name, nil
+}
+
+// removeFromCircles 는 친구 관계가 끊긴 두 유저를 서로의 서클에서 뺀다.
+func removeFromCircles(ctx context.Context, circleRepo repository.CircleRepository, userA, userB primitive.ObjectID) error {
+	if err := circleRepo.RemoveMember(ctx, userA, userB); err != nil {
+		return err
+	}
+	return circleRepo.RemoveMember(ctx, userB, userA)
+}
+
+// circleViewer 는 조회하는 유저가 각 친구의 어느 서클에 속해 있는지 담는다.
+type circleViewer map[primitive.ObjectID][]primitive.ObjectID
+
+// loadCircleViewer 는 서클로 공개 범위를 좁힌 유저에 대해서만 viewerID 가 속한 서클을 조회한다.
+// 친구 관계는 호출하는 쪽에서 확인한다.
+func loadCircleViewer(ctx context.Context, circleRepo repository.CircleRepository, viewerID primitive.ObjectID, users []model.User) (circleViewer, error) {
+	var ownerIDs []primitive.ObjectID
+	for _, u := range users {
+		p := u.PrivacySettings
+		if len(p.StatsCircles) > 0 || len(p.PresenceCircles) > 0 || len(p.SessionCircles) > 0 {
+			ownerIDs = append(ownerIDs, u.ID)
+		}
+	}
+
+	viewer := circleViewer{}
+	if len(ownerIDs) == 0 {
+		return viewer, nil
+	}
+
+	circles, err := circleRepo.FindByOwnersContainingMember(ctx, ownerIDs, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range circles {
+		viewer[c.UserID] = append(viewer[c.UserID], c.ID)
+	}
+	return viewer, nil
+}
+
+// canSeeStats 는 친구인 owner 의 통계를 볼 수 있는지 확인한다. 서클은 친구 공개일 때만 적용한다.
+func (v circleViewer) canSeeStats(owner *model.User) bool {
+	switch statsVisibility(owner) {
+	case model.StatsVisibilityEveryone:
+		return true
+	case model.StatsVisibilityFriends:
+		return v.canSee(owner.ID, owner.PrivacySettings.StatsCircles)
+	default:
+		return false
+	}
+}
+
+// canSee 는 ownerID 가 circles 에게만 공개한 항목을 볼 수 있는지 확인한다. circles 가 비어 있으면 모든 친구에게 공개.
+func (v circleViewer) canSee(ownerID primitive.ObjectID, circles []primitive.ObjectID) bool {
+	if len(circles) == 0 {
+		return true
+	}
+	for _, id := range v[ownerID] {
+		if containsObjectID(circles, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"dangbamgong-backend/internal/domain"
+)
+
+// encodeCursor 는 다음 페이지 위치를 클라이언트가 그대로 돌려보낼 불투명한 문자열로 만든다.
+func encodeCursor(v any) string {
+	b, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return domain.NewBadRequest(domain.ErrInvalidCursor, "invalid cursor")
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return domain.NewBadRequest(domain.ErrInvalidCursor, "invalid cursor")
+	}
+	return nil
+}
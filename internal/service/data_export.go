@@ -0,0 +1,578 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/model"
+	"dangbamgong-backend/internal/repository"
+	"dangbamgong-backend/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const dataExportBuildTimeout = 5 * time.Minute
+
+type DataExportService interface {
+	RequestExport(ctx context.Context, userID string) (*dto.DataExportResponse, error)
+	GetExport(ctx context.Context, userID string) (*dto.DataExportResponse, error)
+	Download(ctx context.Context, exportID string, expires string, signature string) ([]byte, error)
+	RecoverPending(ctx context.Context)
+	Watch(ctx context.Context)
+}
+
+type dataExportService struct {
+	dataExportRepo    repository.DataExportRepository
+	userRepo          repository.UserRepository
+	identityRepo      repository.IdentityRepository
+	activityRepo      repository.ActivityRepository
+	voidSessionRepo   repository.VoidSessionRepository
+	friendshipRepo    repository.FriendshipRepository
+	friendRequestRepo repository.FriendRequestRepository
+	blockRepo         repository.BlockRepository
+	notifRepo         repository.NotificationRepository
+	deviceTokenRepo   repository.DeviceTokenRepository
+	nudgeRepo         repository.NudgeRepository
+	circleRepo        repository.CircleRepository
+	dismissalRepo     repository.SuggestionDismissalRepository
+	feedEventRepo     repository.FeedEventRepository
+	reactionRepo      repository.SessionReactionRepository
+	voidRoomRepo      repository.VoidRoomRepository
+	privateStorage    storage.BlobStorage
+	linkSecret        []byte
+	linkBaseURL       string
+	linkTTL           time.Duration
+	retention         time.Duration
+	cooldown          time.Duration
+}
+
+// DataExportRepositories 는 내보내기 ZIP 에 담을 데이터를 읽는 저장소들이다.
+type DataExportRepositories struct {
+	DataExport      repository.DataExportRepository
+	User            repository.UserRepository
+	Identity        repository.IdentityRepository
+	Activity        repository.ActivityRepository
+	VoidSession     repository.VoidSessionRepository
+	Friendship      repository.FriendshipRepository
+	FriendRequest   repository.FriendRequestRepository
+	Block           repository.BlockRepository
+	Notification    repository.NotificationRepository
+	DeviceToken     repository.DeviceTokenRepository
+	Nudge           repository.NudgeRepository
+	Circle          repository.CircleRepository
+	Dismissal       repository.SuggestionDismissalRepository
+	FeedEvent       repository.FeedEventRepository
+	SessionReaction repository.SessionReactionRepository
+	VoidRoom        repository.VoidRoomRepository
+}
+
+// NewDataExportService 설정
+//   - EXPORT_LINK_SECRET: 다운로드 링크 서명 키 (없으면 시작할 때마다 새로 생성되어 재시작 시 기존 링크 무효)
+//   - EXPORT_LINK_BASE_URL: 다운로드 링크 앞에 붙일 API 주소 (없으면 상대 경로)
+//   - EXPORT_LINK_TTL: 다운로드 링크 유효 기간 (기본 1시간)
+//   - EXPORT_RETENTION: 완성된 파일 보관 기간 (기본 7일)
+//   - EXPORT_COOLDOWN: 새 내보내기를 요청할 수 있는 간격 (기본 24시간)
+func NewDataExportService(repos DataExportRepositories, ps storage.BlobStorage) DataExportService {
+	secret := []byte(os.Getenv("EXPORT_LINK_SECRET"))
+	if len(secret) == 0 {
+		log.Println("[EXPORT] EXPORT_LINK_SECRET not set, download links will not survive a restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatalf("failed to generate export link secret: %v", err)
+		}
+	}
+
+	return &dataExportService{
+		dataExportRepo:    repos.DataExport,
+		userRepo:          repos.User,
+		identityRepo:      repos.Identity,
+		activityRepo:      repos.Activity,
+		voidSessionRepo:   repos.VoidSession,
+		friendshipRepo:    repos.Friendship,
+		friendRequestRepo: repos.FriendRequest,
+		blockRepo:         repos.Block,
+		notifRepo:         repos.Notification,
+		deviceTokenRepo:   repos.DeviceToken,
+		nudgeRepo:         repos.Nudge,
+		circleRepo:        repos.Circle,
+		dismissalRepo:     repos.Dismissal,
+		feedEventRepo:     repos.FeedEvent,
+		reactionRepo:      repos.SessionReaction,
+		voidRoomRepo:      repos.VoidRoom,
+		privateStorage:    ps,
+		linkSecret:        secret,
+		linkBaseURL:       strings.TrimRight(os.Getenv("EXPORT_LINK_BASE_URL"), "/"),
+		linkTTL:           config.GetDuration("EXPORT_LINK_TTL", time.Hour),
+		retention:         config.GetDuration("EXPORT_RETENTION", 7*24*time.Hour),
+		cooldown:          config.GetDuration("EXPORT_COOLDOWN", 24*time.Hour),
+	}
+}
+
+// RequestExport 는 내보내기 작업을 시작한다. 진행 중인 작업이 있으면 그 작업을 반환하고,
+// 마지막 요청 후 EXPORT_COOLDOWN 이 지나지 않았으면 거부한다 (실패한 작업은 제외).
+func (s *dataExportService) RequestExport(ctx context.Context, userID string) (*dto.DataExportResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	now := time.Now()
+	latest, err := s.dataExportRepo.FindLatestByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find export: " + err.Error())
+	}
+	if latest != nil {
+		switch {
+		case latest.Status == model.DataExportPending:
+			return s.toResponse(latest, now), nil
+		case latest.Status != model.DataExportFailed && now.Before(latest.CreatedAt.Add(s.cooldown)):
+			return nil, domain.NewTooManyRequests(domain.ErrExportRateLimited, "export recently requested").
+				WithRetryAfter(latest.CreatedAt.Add(s.cooldown).Sub(now))
+		}
+	}
+
+	// 만든 인스턴스가 바로 작업을 맡음
+	export := &model.DataExport{
+		UserID:    oid,
+		Status:    model.DataExportPending,
+		ClaimedAt: &now,
+		CreatedAt: now,
+	}
+	if err := s.dataExportRepo.Create(ctx, export); err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, domain.NewInternal("failed to create export: " + err.Error())
+		}
+		// 동시에 들어온 다른 요청이 먼저 만든 작업을 반환
+		pending, err := s.dataExportRepo.FindLatestByUserID(ctx, oid)
+		if err != nil || pending == nil {
+			return nil, domain.NewInternal("failed to find export after conflict")
+		}
+		return s.toResponse(pending, now), nil
+	}
+
+	go s.build(*export)
+
+	return s.toResponse(export, now), nil
+}
+
+func (s *dataExportService) GetExport(ctx context.Context, userID string) (*dto.DataExportResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	export, err := s.dataExportRepo.FindLatestByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find export: " + err.Error())
+	}
+	if export == nil {
+		return nil, domain.NewNotFound(domain.ErrExportNotFound, "export not found")
+	}
+
+	return s.toResponse(export, time.Now()), nil
+}
+
+// Download 는 서명된 링크를 검증하고 ZIP 파일을 반환한다.
+func (s *dataExportService) Download(ctx context.Context, exportID string, expires string, signature string) ([]byte, error) {
+	expiresUnix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !s.validSignature(exportID, expires, signature) {
+		return nil, domain.NewForbidden(domain.ErrInvalidDownloadLink, "invalid download link")
+	}
+	now := time.Now()
+	if now.Unix() > expiresUnix {
+		return nil, domain.NewForbidden(domain.ErrInvalidDownloadLink, "download link expired")
+	}
+
+	oid, err := primitive.ObjectIDFromHex(exportID)
+	if err != nil {
+		return nil, domain.NewNotFound(domain.ErrExportNotFound, "export not found")
+	}
+	export, err := s.dataExportRepo.FindByID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find export: " + err.Error())
+	}
+	if export == nil || export.Status != model.DataExportReady || export.ExpiresAt == nil || !now.Before(*export.ExpiresAt) {
+		return nil, domain.NewNotFound(domain.ErrExportNotFound, "export not found")
+	}
+
+	data, err := s.privateStorage.Get(ctx, export.ObjectKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, domain.NewNotFound(domain.ErrExportNotFound, "export file not found")
+	}
+	if err != nil {
+		return nil, domain.NewInternal("failed to read export: " + err.Error())
+	}
+	return data, nil
+}
+
+// RecoverPending 은 서버 재시작으로 중단된 작업을 다시 시작한다.
+// 아무도 맡지 않았거나 빌드 제한 시간이 지나도록 끝나지 않은 작업만 가져오므로 여러 인스턴스에서 실행해도 한 번만 빌드된다.
+func (s *dataExportService) RecoverPending(ctx context.Context) {
+	exports, err := s.dataExportRepo.FindPending(ctx)
+	if err != nil {
+		log.Printf("[EXPORT] failed to recover pending exports: %v\n", err)
+		return
+	}
+
+	recovered := 0
+	for _, export := range exports {
+		now := time.Now()
+		// 빌드는 dataExportBuildTimeout 안에 끝나거나 실패 처리되므로, 그 두 배가 지나도 PENDING 이면 맡은 인스턴스가 죽은 것
+		ok, err := s.dataExportRepo.Claim(ctx, export.ID, now, now.Add(-2*dataExportBuildTimeout))
+		if err != nil {
+			log.Printf("[EXPORT] failed to claim export %s: %v\n", export.ID.Hex(), err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		go s.build(export)
+		recovered++
+	}
+	if recovered > 0 {
+		log.Printf("[EXPORT] recovered %d pending exports\n", recovered)
+	}
+}
+
+// Watch 는 매시간 보관 기간이 지난 파일을 지우고, 다른 인스턴스가 끝내지 못한 작업을 이어받는다.
+func (s *dataExportService) Watch(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.deleteExpired(ctx)
+				s.RecoverPending(ctx)
+			}
+		}
+	}()
+}
+
+func (s *dataExportService) deleteExpired(ctx context.Context) {
+	exports, err := s.dataExportRepo.FindExpired(ctx, time.Now())
+	if err != nil {
+		log.Printf("[EXPORT] failed to find expired exports: %v\n", err)
+		return
+	}
+	for _, export := range exports {
+		if err := s.privateStorage.Delete(ctx, export.ObjectKey); err != nil {
+			log.Printf("[EXPORT] failed to delete export file %s: %v\n", export.ObjectKey, err)
+			continue
+		}
+		if err := s.dataExportRepo.Delete(ctx, export.ID); err != nil {
+			log.Printf("[EXPORT] failed to delete export %s: %v\n", export.ID.Hex(), err)
+		}
+	}
+}
+
+func (s *dataExportService) build(export model.DataExport) {
+	ctx, cancel := context.WithTimeout(context.Background(), dataExportBuildTimeout)
+	defer cancel()
+
+	data, err := s.buildArchive(ctx, export.UserID)
+	if err == nil {
+		key := fmt.Sprintf("exports/%s/%s.zip", export.UserID.Hex(), export.ID.Hex())
+		if err = s.privateStorage.Put(ctx, key, "application/zip", data); err == nil {
+			now := time.Now()
+			err = s.dataExportRepo.MarkReady(ctx, export.ID, key, int64(len(data)), now, now.Add(s.retention))
+		}
+	}
+	if err != nil {
+		log.Printf("[EXPORT] failed to build export %s: %v\n", export.ID.Hex(), err)
+		if err := s.dataExportRepo.MarkFailed(ctx, export.ID, time.Now()); err != nil {
+			log.Printf("[EXPORT] failed to mark export %s failed: %v\n", export.ID.Hex(), err)
+		}
+		return
+	}
+
+	log.Printf("[EXPORT] export %s ready (%d bytes)\n", export.ID.Hex(), len(data))
+}
+
+// buildArchive 는 유저 데이터를 컬렉션별 JSON 과 CSV 로 묶은 ZIP 을 만든다.
+func (s *dataExportService) buildArchive(ctx context.Context, userID primitive.ObjectID) ([]byte, error) {
+	user, err := s.userRepo.FindByIDIncludingPendingDeletion(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find user: %w", err)
+	}
+	if user == nil {
+		return nil, errors.New("user not found")
+	}
+	identities, err := s.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find identities: %w", err)
+	}
+	activities, err := s.activityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find activities: %w", err)
+	}
+	sessions, err := s.voidSessionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find void sessions: %w", err)
+	}
+	friendships, err := s.friendshipRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find friendships: %w", err)
+	}
+	allRequests, err := s.friendRequestRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find friend requests: %w", err)
+	}
+	// 보낸 요청은 앱에서 보이는 상태로 내보냄 (거절 여부는 보낸 사람에게 알리지 않음)
+	requests := make([]model.FriendRequest, 0, len(allRequests))
+	for _, r := range allRequests {
+		if r.SenderID == userID {
+			if r.SenderHidden {
+				continue
+			}
+			// 거절하면서 바뀐 updated_at 으로 거절 시점이 드러나지 않도록 대기 중일 때의 값으로 내보냄
+			if r.Status == model.FriendRequestRejected {
+				r.UpdatedAt = r.CreatedAt
+			}
+			r.Status = senderViewStatus(r.Status)
+		}
+		requests = append(requests, r)
+	}
+	blocks, err := s.blockRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find blocks: %w", err)
+	}
+	notifications, err := s.notifRepo.FindByUserID(ctx, userID, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("find notifications: %w", err)
+	}
+	deviceTokens, err := s.deviceTokenRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find device tokens: %w", err)
+	}
+	nudges, err := s.nudgeRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find nudges: %w", err)
+	}
+	circles, err := s.circleRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find circles: %w", err)
+	}
+	dismissals, err := s.dismissalRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find suggestion dismissals: %w", err)
+	}
+	feedEvents, err := s.feedEventRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find feed events: %w", err)
+	}
+	reactions, err := s.reactionRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find session reactions: %w", err)
+	}
+	rooms, err := s.voidRoomRepo.FindByParticipantID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("find void rooms: %w", err)
+	}
+
+	tables := []exportTable{
+		{
+			name:   "user",
+			data:   user,
+			header: []string{"id", "nickname", "tag", "created_at", "stats_visibility", "void_reminder", "reminder_hours", "friend_request", "friend_nudge"},
+			rows: [][]string{{
+				user.ID.Hex(), user.Nickname, user.Tag, formatExportTime(&user.CreatedAt),
+				string(user.PrivacySettings.StatsVisibility),
+				strconv.FormatBool(user.NotificationSettings.VoidReminder),
+				strconv.Itoa(user.NotificationSettings.ReminderHours),
+				strconv.FormatBool(user.NotificationSettings.FriendRequest),
+				strconv.FormatBool(user.NotificationSettings.FriendNudge),
+			}},
+		},
+		newExportTable("identities", identities, []string{"provider", "social_id", "created_at", "last_used_at"},
+			func(i model.Identity) []string {
+				return []string{string(i.Provider), i.SocialID, formatExportTime(&i.CreatedAt), formatExportTime(i.LastUsedAt)}
+			}),
+		newExportTable("activities", activities, []string{"id", "name", "usage_count", "last_used_at", "created_at"},
+			func(a model.Activity) []string {
+				return []string{a.ID.Hex(), a.Name, strconv.Itoa(a.UsageCount), formatExportTime(a.LastUsedAt), formatExportTime(&a.CreatedAt)}
+			}),
+		newExportTable("void_sessions", sessions, []string{"id", "target_day", "started_at", "ended_at", "duration_sec", "activities", "room_id"},
+			func(v model.VoidSession) []string {
+				roomID := ""
+				if v.RoomID != nil {
+					roomID = v.RoomID.Hex()
+				}
+				return []string{v.ID.Hex(), v.TargetDay, formatExportTime(&v.StartedAt), formatExportTime(&v.EndedAt), strconv.FormatInt(v.DurationSec, 10), strings.Join(v.Activities, ";"), roomID}
+			}),
+		newExportTable("friendships", friendships, []string{"friend_id", "created_at"},
+			func(f model.Friendship) []string {
+				return []string{f.FriendID.Hex(), formatExportTime(&f.CreatedAt)}
+			}),
+		newExportTable("friend_requests", requests, []string{"id", "sender_id", "receiver_id", "status", "created_at", "updated_at"},
+			func(r model.FriendRequest) []string {
+				return []string{r.ID.Hex(), r.SenderID.Hex(), r.ReceiverID.Hex(), string(r.Status), formatExportTime(&r.CreatedAt), formatExportTime(&r.UpdatedAt)}
+			}),
+		newExportTable("blocks", blocks, []string{"blocked_id", "created_at"},
+			func(b model.Block) []string {
+				return []string{b.BlockedID.Hex(), formatExportTime(&b.CreatedAt)}
+			}),
+		newExportTable("notifications", notifications, []string{"id", "type", "title", "body", "is_read", "created_at"},
+			func(n model.Notification) []string {
+				return []string{n.ID.Hex(), string(n.Type), n.Title, n.Body, strconv.FormatBool(n.IsRead), formatExportTime(&n.CreatedAt)}
+			}),
+		newExportTable("device_tokens", deviceTokens, []string{"token", "created_at", "updated_at"},
+			func(d model.DeviceToken) []string {
+				return []string{d.Token, formatExportTime(&d.CreatedAt), formatExportTime(&d.UpdatedAt)}
+			}),
+		newExportTable("nudges", nudges, []string{"id", "sender_id", "receiver_id", "target_day", "created_at"},
+			func(n model.Nudge) []string {
+				return []string{n.ID.Hex(), n.SenderID.Hex(), n.ReceiverID.Hex(), n.TargetDay, formatExportTime(&n.CreatedAt)}
+			}),
+		newExportTable("circles", circles, []string{"id", "name", "is_close_friends", "member_ids", "created_at"},
+			func(c model.Circle) []string {
+				memberIDs := make([]string, len(c.MemberIDs))
+				for i, id := range c.MemberIDs {
+					memberIDs[i] = id.Hex()
+				}
+				return []string{c.ID.Hex(), c.Name, strconv.FormatBool(c.IsCloseFriends), strings.Join(memberIDs, ";"), formatExportTime(&c.CreatedAt)}
+			}),
+		newExportTable("suggestion_dismissals", dismissals, []string{"dismissed_id", "created_at"},
+			func(d model.SuggestionDismissal) []string {
+				return []string{d.DismissedID.Hex(), formatExportTime(&d.CreatedAt)}
+			}),
+		newExportTable("feed_events", feedEvents, []string{"id", "type", "session_id", "target_day", "duration_sec", "streak_days", "created_at"},
+			func(e model.FeedEvent) []string {
+				return []string{e.ID.Hex(), string(e.Type), e.SessionID.Hex(), e.TargetDay, strconv.FormatInt(e.DurationSec, 10), strconv.Itoa(e.StreakDays), formatExportTime(&e.CreatedAt)}
+			}),
+		newExportTable("session_reactions", reactions, []string{"session_id", "owner_id", "emoji", "created_at", "updated_at"},
+			func(r model.SessionReaction) []string {
+				return []string{r.SessionID.Hex(), r.OwnerID.Hex(), string(r.Emoji), formatExportTime(&r.CreatedAt), formatExportTime(&r.UpdatedAt)}
+			}),
+		newExportTable("void_rooms", rooms, []string{"id", "owner_id", "status", "total_duration_sec", "session_count", "created_at", "closed_at"},
+			func(r model.VoidRoom) []string {
+				return []string{r.ID.Hex(), r.OwnerID.Hex(), string(r.Status), strconv.FormatInt(r.TotalDurationSec, 10), strconv.Itoa(r.SessionCount), formatExportTime(&r.CreatedAt), formatExportTime(r.ClosedAt)}
+			}),
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, t := range tables {
+		if err := t.write(zw); err != nil {
+			return nil, fmt.Errorf("write %s: %w", t.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *dataExportService) toResponse(export *model.DataExport, now time.Time) *dto.DataExportResponse {
+	resp := &dto.DataExportResponse{
+		ExportID:    export.ID.Hex(),
+		Status:      string(export.Status),
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		SizeBytes:   export.SizeBytes,
+		ExpiresAt:   export.ExpiresAt,
+	}
+	if export.Status != model.DataExportReady || export.ExpiresAt == nil || !now.Before(*export.ExpiresAt) {
+		return resp
+	}
+
+	// 링크는 파일 보관 기한보다 오래 유효하지 않음
+	linkExpiresAt := now.Add(s.linkTTL)
+	if linkExpiresAt.After(*export.ExpiresAt) {
+		linkExpiresAt = *export.ExpiresAt
+	}
+	expires := strconv.FormatInt(linkExpiresAt.Unix(), 10)
+	url := fmt.Sprintf("%s/exports/%s/download?expires=%s&sig=%s",
+		s.linkBaseURL, export.ID.Hex(), expires, s.sign(export.ID.Hex(), expires))
+
+	resp.DownloadURL = &url
+	resp.DownloadURLExpireAt = &linkExpiresAt
+	return resp
+}
+
+func (s *dataExportService) sign(exportID string, expires string) string {
+	mac := hmac.New(sha256.New, s.linkSecret)
+	mac.Write([]byte(exportID + "." + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *dataExportService) validSignature(exportID string, expires string, signature string) bool {
+	expected := s.sign(exportID, expires)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// exportTable 은 ZIP 안의 <name>.json, <name>.csv 한 쌍이다.
+type exportTable struct {
+	name   string
+	data   any
+	header []string
+	rows   [][]string
+}
+
+func newExportTable[T any](name string, items []T, header []string, row func(T) []string) exportTable {
+	if items == nil {
+		items = []T{}
+	}
+	rows := make([][]string, len(items))
+	for i, item := range items {
+		rows[i] = row(item)
+	}
+	return exportTable{name: name, data: items, header: header, rows: rows}
+}
+
+func (t exportTable) write(zw *zip.Writer) error {
+	jw, err := zw.Create(t.name + ".json")
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(jw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(t.data); err != nil {
+		return err
+	}
+
+	cw, err := zw.Create(t.name + ".csv")
+	if err != nil {
+		return err
+	}
+	// 엑셀에서 한글이 깨지지 않도록 UTF-8 BOM 추가
+	if _, err := cw.Write([]byte("\xEF\xBB\xBF")); err != nil {
+		return err
+	}
+	w := csv.NewWriter(cw)
+	if err := w.Write(t.header); err != nil {
+		return err
+	}
+	if err := w.WriteAll(t.rows); err != nil {
+		return err
+	}
+	return w.Error()
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.In(config.KST).Format(time.RFC3339)
+}
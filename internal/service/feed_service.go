@@ -0,0 +1,314 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/model"
+	"dangbamgong-backend/internal/repository"
+	"dangbamgong-backend/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 연속 공백 일수가 이 값에 도달하면 피드에 남김
+var streakMilestones = []int{3, 7, 14, 30, 50, 100, 200, 365}
+
+const (
+	defaultFeedPageSize = 20
+	maxFeedPageSize     = 50
+	recordMinSessions   = 3 // 이만큼 공백한 뒤부터 최고 기록 경신을 피드에 남김
+)
+
+type FeedService interface {
+	GetFeed(ctx context.Context, userID string, cursor string, limit int) (*dto.FeedResponse, error)
+	PublishSessionEnded(ctx context.Context, session *model.VoidSession, previous *model.VoidUserStats)
+}
+
+type feedService struct {
+	feedEventRepo   repository.FeedEventRepository
+	userRepo        repository.UserRepository
+	friendshipRepo  repository.FriendshipRepository
+	blockRepo       repository.BlockRepository
+	circleRepo      repository.CircleRepository
+	voidSessionRepo repository.VoidSessionRepository
+	reactionRepo    repository.SessionReactionRepository
+	blobStorage     storage.BlobStorage
+}
+
+func NewFeedService(
+	fer repository.FeedEventRepository,
+	ur repository.UserRepository,
+	fr repository.FriendshipRepository,
+	br repository.BlockRepository,
+	cr repository.CircleRepository,
+	vr repository.VoidSessionRepository,
+	srr repository.SessionReactionRepository,
+	bs storage.BlobStorage,
+) FeedService {
+	return &feedService{
+		feedEventRepo:   fer,
+		userRepo:        ur,
+		friendshipRepo:  fr,
+		blockRepo:       br,
+		circleRepo:      cr,
+		voidSessionRepo: vr,
+		reactionRepo:    srr,
+		blobStorage:     bs,
+	}
+}
+
+type feedCursor struct {
+	At int64  `json:"a"` // 마지막 항목의 created_at (unix ms)
+	ID string `json:"i"`
+}
+
+// GetFeed 는 친구들의 활동을 최신순으로 반환한다.
+// 공개 범위는 조회할 때 적용하므로, 설정을 바꾸면 지난 활동에도 바로 반영된다.
+func (s *feedService) GetFeed(ctx context.Context, userID string, cursor string, limit int) (*dto.FeedResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	if limit <= 0 {
+		limit = defaultFeedPageSize
+	}
+	limit = min(limit, maxFeedPageSize)
+
+	query := repository.FeedQuery{Limit: int64(limit) + 1}
+	if cursor != "" {
+		var after feedCursor
+		if err := decodeCursor(cursor, &after); err != nil {
+			return nil, err
+		}
+		beforeID, err := primitive.ObjectIDFromHex(after.ID)
+		if err != nil {
+			return nil, domain.NewBadRequest(domain.ErrInvalidCursor, "invalid cursor")
+		}
+		beforeAt := time.UnixMilli(after.At)
+		query.BeforeAt = &beforeAt
+		query.BeforeID = beforeID
+	}
+
+	users, err := s.findFriends(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+
+	viewer, err := loadCircleViewer(ctx, s.circleRepo, oid, users)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find circles: " + err.Error())
+	}
+
+	userMap := make(map[primitive.ObjectID]*model.User, len(users))
+	for i := range users {
+		u := &users[i]
+		userMap[u.ID] = u
+		if viewer.canSee(u.ID, u.PrivacySettings.SessionCircles) {
+			query.SessionUserIDs = append(query.SessionUserIDs, u.ID)
+		}
+		if viewer.canSeeStats(u) {
+			query.StatUserIDs = append(query.StatUserIDs, u.ID)
+		}
+	}
+
+	resp := &dto.FeedResponse{Items: []dto.FeedItem{}}
+	if len(query.SessionUserIDs) == 0 && len(query.StatUserIDs) == 0 {
+		return resp, nil
+	}
+
+	events, err := s.feedEventRepo.Find(ctx, query)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find feed events: " + err.Error())
+	}
+
+	if len(events) > limit {
+		events = events[:limit]
+		last := events[limit-1]
+		next := encodeCursor(feedCursor{At: last.CreatedAt.UnixMilli(), ID: last.ID.Hex()})
+		resp.NextCursor = &next
+	}
+
+	myReactions, err := s.findMyReactions(ctx, oid, events)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range events {
+		u := userMap[e.UserID]
+		item := dto.FeedItem{
+			ID:   e.ID.Hex(),
+			Type: string(e.Type),
+			User: dto.UserSearchItem{
+				UserID:   u.ID.Hex(),
+				Nickname: u.Nickname,
+				Tag:      u.Tag,
+				Avatar:   avatarURLs(s.blobStorage, u.AvatarKey),
+			},
+			SessionID: e.SessionID.Hex(),
+			TargetDay: e.TargetDay,
+			CreatedAt: e.CreatedAt,
+		}
+		switch e.Type {
+		case model.FeedSessionEnded:
+			item.DurationSec = &e.DurationSec
+			if emoji, ok := myReactions[e.SessionID]; ok {
+				item.MyReaction = &emoji
+			}
+			if u.PrivacySettings.ShareActivities {
+				item.Activities = e.Activities
+			}
+		case model.FeedPersonalRecord:
+			item.DurationSec = &e.DurationSec
+		case model.FeedStreakMilestone:
+			item.StreakDays = &e.StreakDays
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
+// findMyReactions 는 피드의 끝난 공백에 내가 남긴 반응을 세션별로 반환한다.
+func (s *feedService) findMyReactions(ctx context.Context, userID primitive.ObjectID, events []model.FeedEvent) (map[primitive.ObjectID]string, error) {
+	var sessionIDs []primitive.ObjectID
+	for _, e := range events {
+		if e.Type == model.FeedSessionEnded {
+			sessionIDs = append(sessionIDs, e.SessionID)
+		}
+	}
+	if len(sessionIDs) == 0 {
+		return nil, nil
+	}
+
+	reactions, err := s.reactionRepo.FindByUserAndSessionIDs(ctx, userID, sessionIDs)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find reactions: " + err.Error())
+	}
+	mine := make(map[primitive.ObjectID]string, len(reactions))
+	for _, r := range reactions {
+		mine[r.SessionID] = string(r.Emoji)
+	}
+	return mine, nil
+}
+
+// findFriends 는 피드에 보여줄 친구를 반환한다. 친구 관계가 남아 있더라도 어느 쪽이든 차단했으면 뺀다.
+func (s *feedService) findFriends(ctx context.Context, userID primitive.ObjectID) ([]model.User, error) {
+	friendships, err := s.friendshipRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find friendships: " + err.Error())
+	}
+	if len(friendships) == 0 {
+		return nil, nil
+	}
+
+	blocks, err := s.blockRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find blocks: " + err.Error())
+	}
+	blockedBy, err := s.blockRepo.FindByBlockedID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find blocks: " + err.Error())
+	}
+	blocked := make(map[primitive.ObjectID]bool, len(blocks)+len(blockedBy))
+	for _, b := range blocks {
+		blocked[b.BlockedID] = true
+	}
+	for _, b := range blockedBy {
+		blocked[b.UserID] = true
+	}
+
+	friendIDs := make([]primitive.ObjectID, 0, len(friendships))
+	for _, f := range friendships {
+		if !blocked[f.FriendID] {
+			friendIDs = append(friendIDs, f.FriendID)
+		}
+	}
+	if len(friendIDs) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, friendIDs)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find users: " + err.Error())
+	}
+	return users, nil
+}
+
+// PublishSessionEnded 는 끝난 공백과, 그로 인한 연속 기록·최고 기록을 피드에 남긴다.
+// previous 는 이 세션을 저장하기 전의 누적 통계다. 피드 기록에 실패해도 공백 종료는 그대로 처리되도록 로그만 남긴다.
+func (s *feedService) PublishSessionEnded(ctx context.Context, session *model.VoidSession, previous *model.VoidUserStats) {
+	events := []model.FeedEvent{{
+		Type:        model.FeedSessionEnded,
+		DurationSec: session.DurationSec,
+		Activities:  session.Activities,
+	}}
+
+	if previous != nil && previous.SessionCount >= recordMinSessions && session.DurationSec > previous.MaxDurationSec {
+		events = append(events, model.FeedEvent{
+			Type:        model.FeedPersonalRecord,
+			DurationSec: session.DurationSec,
+		})
+	}
+
+	streak, err := s.streakMilestone(ctx, session)
+	if err != nil {
+		log.Printf("[FEED] failed to calculate streak for user %s: %v\n", session.UserID.Hex(), err)
+	}
+	if streak > 0 {
+		events = append(events, model.FeedEvent{
+			Type:       model.FeedStreakMilestone,
+			StreakDays: streak,
+		})
+	}
+
+	for i := range events {
+		e := &events[i]
+		e.UserID = session.UserID
+		e.SessionID = session.ID
+		e.TargetDay = session.TargetDay
+		e.CreatedAt = session.EndedAt
+		if err := s.feedEventRepo.Create(ctx, e); err != nil {
+			log.Printf("[FEED] failed to create %s event for user %s: %v\n", e.Type, session.UserID.Hex(), err)
+		}
+	}
+}
+
+// streakMilestone 은 이 세션이 그날의 첫 공백이고, 그 결과 연속 공백 일수가 기념할 만한 수에 도달했으면 그 일수를 반환한다.
+func (s *feedService) streakMilestone(ctx context.Context, session *model.VoidSession) (int, error) {
+	// 같은 날 두 번째 공백부터는 연속 일수가 늘지 않음
+	sameDay, err := s.voidSessionRepo.FindByUserIDAndTargetDay(ctx, session.UserID, session.TargetDay)
+	if err != nil {
+		return 0, err
+	}
+	if len(sameDay) > 1 {
+		return 0, nil
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", session.TargetDay, config.KST)
+	if err != nil {
+		return 0, err
+	}
+	fromDay := day.AddDate(0, 0, -streakLookbackDays).Format("2006-01-02")
+
+	durations, err := s.voidSessionRepo.AggregateDailyDurations(ctx, session.UserID, fromDay)
+	if err != nil {
+		return 0, err
+	}
+	days := make(map[string]int64, len(durations))
+	for _, d := range durations {
+		days[d.TargetDay] = d.TotalDurationSec
+	}
+
+	streak := consecutiveDays(days, day)
+	if !slices.Contains(streakMilestones, streak) {
+		return 0, nil
+	}
+	return streak, nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/repository"
+)
+
+const friendRequestExpiryBatchSize = 100
+
+// 거절된 뒤 같은 유저에게 다시 요청할 수 있을 때까지의 기본 기간 (FRIEND_REQUEST_REJECT_COOLDOWN)
+const defaultFriendRequestRejectCooldown = 30 * 24 * time.Hour
+
+// FriendRequestExpiry 는 오래 응답이 없는 친구 요청을 만료시키고, 만료된 요청을 정리한다.
+// 거절된 요청도 보낸 사람에게는 대기 중으로 보이므로 같은 시점에 만료된다.
+//   - FRIEND_REQUEST_TTL: 요청이 만료되기까지의 기간 (기본 30일)
+//   - FRIEND_REQUEST_RETENTION: 만료된 요청을 보관하는 기간 (기본 7일). 재요청 제한 중인 요청은 제한이 끝날 때까지 보관
+//   - FRIEND_REQUEST_EXPIRY_NOTIFY: 만료 시 보낸 사람에게 알림 (기본 false)
+type FriendRequestExpiry struct {
+	friendRequestRepo repository.FriendRequestRepository
+	userRepo          repository.UserRepository
+	notifSvc          NotificationService
+	ttl               time.Duration
+	retention         time.Duration
+	rejectCooldown    time.Duration
+	notify            bool
+}
+
+func NewFriendRequestExpiry(frr repository.FriendRequestRepository, ur repository.UserRepository, ns NotificationService) *FriendRequestExpiry {
+	return &FriendRequestExpiry{
+		friendRequestRepo: frr,
+		userRepo:          ur,
+		notifSvc:          ns,
+		ttl:               config.GetDuration("FRIEND_REQUEST_TTL", 30*24*time.Hour),
+		retention:         config.GetDuration("FRIEND_REQUEST_RETENTION", 7*24*time.Hour),
+		rejectCooldown:    config.GetDuration("FRIEND_REQUEST_REJECT_COOLDOWN", defaultFriendRequestRejectCooldown),
+		notify:            config.GetBool("FRIEND_REQUEST_EXPIRY_NOTIFY", false),
+	}
+}
+
+// ExpireStale 은 FRIEND_REQUEST_TTL 이 지난 대기·거절 요청을 만료시키고 만료한 수를 반환한다.
+func (e *FriendRequestExpiry) ExpireStale(ctx context.Context) int {
+	expired := 0
+	for {
+		requests, err := e.friendRequestRepo.FindOpenCreatedBefore(ctx, time.Now().Add(-e.ttl), friendRequestExpiryBatchSize)
+		if err != nil {
+			log.Printf("[FRIEND] failed to find stale requests: %v\n", err)
+			return expired
+		}
+
+		failed := 0
+		for _, r := range requests {
+			ok, err := e.friendRequestRepo.ExpireIfOpen(ctx, r.ID)
+			if err != nil {
+				log.Printf("[FRIEND] failed to expire request %s: %v\n", r.ID.Hex(), err)
+				failed++
+				continue
+			}
+			if !ok {
+				continue
+			}
+			expired++
+
+			// 보낸 사람이 이미 취소한 요청은 알리지 않음
+			if e.notify && !r.SenderHidden {
+				receiver, err := e.userRepo.FindByID(ctx, r.ReceiverID)
+				if err == nil && receiver != nil {
+					_ = e.notifSvc.SendFriendRequestExpired(ctx, r.SenderID, receiver.Nickname)
+				}
+			}
+		}
+
+		// 실패한 요청만 남았으면 다음 실행에서 다시 시도
+		if len(requests) < friendRequestExpiryBatchSize || failed == len(requests) {
+			return expired
+		}
+	}
+}
+
+// Cleanup 은 만료 후 FRIEND_REQUEST_RETENTION 이 지난 요청을 지운다.
+func (e *FriendRequestExpiry) Cleanup(ctx context.Context) int64 {
+	now := time.Now()
+	deleted, err := e.friendRequestRepo.DeleteExpiredBefore(ctx, now.Add(-e.retention), now.Add(-e.rejectCooldown))
+	if err != nil {
+		log.Printf("[FRIEND] failed to clean up expired requests: %v\n", err)
+	}
+	return deleted
+}
+
+// Watch 는 FRIEND_REQUEST_EXPIRY_INTERVAL(기본 1시간)마다 요청을 만료·정리한다.
+func (e *FriendRequestExpiry) Watch(ctx context.Context) {
+	interval := config.GetDuration("FRIEND_REQUEST_EXPIRY_INTERVAL", time.Hour)
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if n := e.ExpireStale(ctx); n > 0 {
+				log.Printf("[FRIEND] expired %d friend requests\n", n)
+			}
+			if n := e.Cleanup(ctx); n > 0 {
+				log.Printf("[FRIEND] deleted %d expired friend requests\n", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -2,33 +2,64 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
+	"dangbamgong-backend/internal/config"
 	"dangbamgong-backend/internal/domain"
 	"dangbamgong-backend/internal/dto"
 	"dangbamgong-backend/internal/model"
 	"dangbamgong-backend/internal/repository"
+	"dangbamgong-backend/internal/storage"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	inviteCodeLength  = 8
+	inviteCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // 헷갈리기 쉬운 0, O, 1, I 제외
 )
 
 type FriendService interface {
-	GetFriends(ctx context.Context, userID string) (*dto.FriendListResponse, error)
+	GetFriends(ctx context.Context, userID string, query dto.FriendListQuery) (*dto.FriendListResponse, error)
 	RemoveFriend(ctx context.Context, userID string, targetID string) error
-	GetRequests(ctx context.Context, userID string, requestType string) (interface{}, error)
+	GetSuggestions(ctx context.Context, userID string, limit int) (*dto.FriendSuggestionListResponse, error)
+	DismissSuggestion(ctx context.Context, userID string, targetID string) error
+	GetRequests(ctx context.Context, userID string, requestType string, status string) (interface{}, error)
 	SendRequest(ctx context.Context, userID string, req dto.SendFriendRequestRequest) (*dto.SendFriendRequestResponse, error)
 	AcceptRequest(ctx context.Context, userID string, requestID string) error
 	RejectRequest(ctx context.Context, userID string, requestID string) error
 	DeleteRequest(ctx context.Context, userID string, requestID string) error
 	Nudge(ctx context.Context, userID string, targetID string) error
+	GetNudges(ctx context.Context, userID string) (*dto.NudgeListResponse, error)
+	CreateInvite(ctx context.Context, userID string, req dto.CreateFriendInviteRequest) (*dto.FriendInviteResponse, error)
+	RedeemInvite(ctx context.Context, userID string, code string) (*dto.RedeemFriendInviteResponse, error)
 }
 
 type friendService struct {
-	userRepo          repository.UserRepository
-	blockRepo         repository.BlockRepository
-	friendshipRepo    repository.FriendshipRepository
-	friendRequestRepo repository.FriendRequestRepository
-	notifSvc          NotificationService
+	userRepo            repository.UserRepository
+	blockRepo           repository.BlockRepository
+	friendshipRepo      repository.FriendshipRepository
+	friendRequestRepo   repository.FriendRequestRepository
+	nicknameHistoryRepo repository.NicknameHistoryRepository
+	nudgeRepo           repository.NudgeRepository
+	friendInviteRepo    repository.FriendInviteRepository
+	circleRepo          repository.CircleRepository
+	dismissalRepo       repository.SuggestionDismissalRepository
+	notifSvc            NotificationService
+	blobStorage         storage.BlobStorage
+	nicknameHintPeriod  time.Duration
+	nudgeCooldown       time.Duration
+	nudgeDailyLimit     int
+	inviteTTL           time.Duration
+	inviteMaxUses       int
+	inviteBaseURL       string
+	rejectCooldown      time.Duration
 }
 
 func NewFriendService(
@@ -36,23 +67,154 @@ func NewFriendService(
 	br repository.BlockRepository,
 	fr repository.FriendshipRepository,
 	frr repository.FriendRequestRepository,
+	nhr repository.NicknameHistoryRepository,
+	nur repository.NudgeRepository,
+	fir repository.FriendInviteRepository,
+	cr repository.CircleRepository,
+	sdr repository.SuggestionDismissalRepository,
 	ns NotificationService,
+	bs storage.BlobStorage,
 ) FriendService {
 	return &friendService{
-		userRepo:          ur,
-		blockRepo:         br,
-		friendshipRepo:    fr,
-		friendRequestRepo: frr,
-		notifSvc:          ns,
+		userRepo:            ur,
+		blockRepo:           br,
+		friendshipRepo:      fr,
+		friendRequestRepo:   frr,
+		nicknameHistoryRepo: nhr,
+		nudgeRepo:           nur,
+		friendInviteRepo:    fir,
+		circleRepo:          cr,
+		dismissalRepo:       sdr,
+		notifSvc:            ns,
+		blobStorage:         bs,
+		nicknameHintPeriod:  config.GetDuration("NICKNAME_HINT_PERIOD", 14*24*time.Hour),
+		nudgeCooldown:       config.GetDuration("NUDGE_COOLDOWN", 10*time.Minute),
+		nudgeDailyLimit:     config.GetInt("NUDGE_DAILY_LIMIT", 20),
+		inviteTTL:           config.GetDuration("FRIEND_INVITE_TTL", 72*time.Hour),
+		inviteMaxUses:       config.GetInt("FRIEND_INVITE_MAX_USES", 10),
+		inviteBaseURL:       strings.TrimRight(os.Getenv("FRIEND_INVITE_BASE_URL"), "/"),
+		rejectCooldown:      config.GetDuration("FRIEND_REQUEST_REJECT_COOLDOWN", defaultFriendRequestRejectCooldown),
+	}
+}
+
+// 친구 목록 정렬 기준
+const (
+	friendSortFriendship = "FRIENDSHIP" // 친구가 된 날짜 최신순
+	friendSortInVoid     = "IN_VOID"    // 공백 중인 친구 먼저, 그다음 최근 활동순
+	friendSortRecent     = "RECENT"     // 최근에 공백을 마친 순
+	friendSortNickname   = "NICKNAME"   // 닉네임순
+)
+
+const (
+	defaultFriendPageSize = 50
+	maxFriendPageSize     = 100
+)
+
+// friendCursor 는 정렬 기준에서 항목의 위치다. 모든 값은 오름차순으로 비교한다.
+type friendCursor struct {
+	Sort string `json:"s"`
+	A    int64  `json:"a"`
+	B    int64  `json:"b"`
+	Text string `json:"t"`
+	ID   string `json:"i"`
+}
+
+func (c friendCursor) less(o friendCursor) bool {
+	if c.A != o.A {
+		return c.A < o.A
+	}
+	if c.B != o.B {
+		return c.B < o.B
+	}
+	if c.Text != o.Text {
+		return c.Text < o.Text
+	}
+	return c.ID < o.ID
+}
+
+func friendSortKey(sortBy string, item *dto.FriendItem) friendCursor {
+	key := friendCursor{Sort: sortBy, ID: item.UserID}
+	var lastEnded int64
+	if item.LastVoidEndedAt != nil {
+		lastEnded = -item.LastVoidEndedAt.UnixMilli()
+	}
+
+	switch sortBy {
+	case friendSortInVoid:
+		if !item.IsInVoid {
+			key.A = 1
+		}
+		key.B = lastEnded
+		key.Text = strings.ToLower(item.Nickname)
+	case friendSortRecent:
+		key.A = lastEnded
+		key.Text = strings.ToLower(item.Nickname)
+	case friendSortNickname:
+		key.Text = strings.ToLower(item.Nickname)
+	default:
+		key.A = -item.CreatedAt.UnixMilli()
+	}
+	return key
+}
+
+// pageFriends 는 items 를 정렬한 뒤 after 바로 다음부터 limit 개를 자르고, 남은 항목이 있으면 다음 커서를 만든다.
+func pageFriends(items []dto.FriendItem, sortBy string, after *friendCursor, limit int) ([]dto.FriendItem, *string) {
+	sort.Slice(items, func(i, j int) bool {
+		return friendSortKey(sortBy, &items[i]).less(friendSortKey(sortBy, &items[j]))
+	})
+
+	start := 0
+	if after != nil {
+		start = sort.Search(len(items), func(i int) bool {
+			return after.less(friendSortKey(sortBy, &items[i]))
+		})
+	}
+	end := min(start+limit, len(items))
+	page := items[start:end]
+	if end == len(items) {
+		return page, nil
 	}
+	next := encodeCursor(friendSortKey(sortBy, &page[len(page)-1]))
+	return page, &next
 }
 
-func (s *friendService) GetFriends(ctx context.Context, userID string) (*dto.FriendListResponse, error) {
+// GetFriends 는 공백 상태를 나에게 공개하지 않은 친구의 isInVoid, lastVoidEndedAt 을 숨긴다.
+// 숨긴 값이 순서로 드러나지 않도록 정렬도 숨긴 뒤의 값으로 한다.
+// 친구 수가 많지 않다고 보고 매 요청마다 전체를 불러와 정렬한 뒤 커서 위치부터 자른다.
+// IN_VOID, RECENT 커서는 최선 노력이다. 페이지 사이에 친구가 공백을 시작하거나 마치면 위치가 바뀌어
+// 빠지거나 두 번 나오는 친구가 있을 수 있다. FRIENDSHIP, NICKNAME 은 닉네임 변경 외에는 순서가 바뀌지 않는다.
+func (s *friendService) GetFriends(ctx context.Context, userID string, query dto.FriendListQuery) (*dto.FriendListResponse, error) {
 	oid, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
 		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
 	}
 
+	sortBy := strings.ToUpper(query.Sort)
+	switch sortBy {
+	case "":
+		sortBy = friendSortFriendship
+	case friendSortFriendship, friendSortInVoid, friendSortRecent, friendSortNickname:
+	default:
+		return nil, domain.NewBadRequest(domain.ErrInvalidFriendSort, "sort must be FRIENDSHIP, IN_VOID, RECENT or NICKNAME")
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultFriendPageSize
+	}
+	limit = min(limit, maxFriendPageSize)
+
+	var after *friendCursor
+	if query.Cursor != "" {
+		after = &friendCursor{}
+		if err := decodeCursor(query.Cursor, after); err != nil {
+			return nil, err
+		}
+		if after.Sort != sortBy {
+			return nil, domain.NewBadRequest(domain.ErrInvalidCursor, "cursor does not match sort")
+		}
+	}
+
 	friendships, err := s.friendshipRepo.FindByUserID(ctx, oid)
 	if err != nil {
 		return nil, domain.NewInternal("failed to find friendships: " + err.Error())
@@ -77,23 +239,91 @@ func (s *friendService) GetFriends(ctx context.Context, userID string) (*dto.Fri
 		userMap[users[i].ID] = &users[i]
 	}
 
+	viewer, err := loadCircleViewer(ctx, s.circleRepo, oid, users)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find circles: " + err.Error())
+	}
+
+	// 닉네임은 대소문자 구분 없이, 태그는 대문자로 부분 일치
+	q := strings.TrimSpace(query.Query)
+	lowerQ, upperQ := strings.ToLower(q), strings.ToUpper(q)
+
 	items := make([]dto.FriendItem, 0, len(friendships))
 	for _, f := range friendships {
 		u, ok := userMap[f.FriendID]
 		if !ok {
 			continue
 		}
-		items = append(items, dto.FriendItem{
-			UserID:          u.ID.Hex(),
-			Nickname:        u.Nickname,
-			Tag:             u.Tag,
-			IsInVoid:        u.IsInVoid,
-			LastVoidEndedAt: u.LastVoidEndedAt,
-			CreatedAt:       f.CreatedAt,
-		})
+		if q != "" && !strings.Contains(strings.ToLower(u.Nickname), lowerQ) && !strings.Contains(u.Tag, upperQ) {
+			continue
+		}
+		item := dto.FriendItem{
+			UserID:    u.ID.Hex(),
+			Nickname:  u.Nickname,
+			Tag:       u.Tag,
+			Avatar:    avatarURLs(s.blobStorage, u.AvatarKey),
+			CircleIDs: []string{},
+			CreatedAt: f.CreatedAt,
+		}
+		if viewer.canSee(u.ID, u.PrivacySettings.PresenceCircles) {
+			item.IsInVoid = u.IsInVoid
+			item.LastVoidEndedAt = u.LastVoidEndedAt
+		}
+		items = append(items, item)
 	}
 
-	return &dto.FriendListResponse{Friends: items}, nil
+	page, next := pageFriends(items, sortBy, after, limit)
+	resp := &dto.FriendListResponse{TotalCount: len(items), NextCursor: next}
+
+	if len(page) > 0 {
+		pageIDs := make([]primitive.ObjectID, len(page))
+		for i := range page {
+			pageIDs[i], _ = primitive.ObjectIDFromHex(page[i].UserID)
+		}
+
+		previousNicknames, err := s.findPreviousNicknames(ctx, pageIDs)
+		if err != nil {
+			return nil, domain.NewInternal("failed to find nickname histories: " + err.Error())
+		}
+
+		myCircles, err := s.circleRepo.FindByUserID(ctx, oid)
+		if err != nil {
+			return nil, domain.NewInternal("failed to find circles: " + err.Error())
+		}
+
+		for i := range page {
+			id := pageIDs[i]
+			if prev, ok := previousNicknames[id]; ok && prev != page[i].Nickname {
+				page[i].PreviousNickname = &prev
+			}
+			for _, c := range myCircles {
+				if containsObjectID(c.MemberIDs, id) {
+					page[i].CircleIDs = append(page[i].CircleIDs, c.ID.Hex())
+				}
+			}
+		}
+	}
+
+	resp.Friends = page
+	return resp, nil
+}
+
+// findPreviousNicknames 는 최근 닉네임을 변경한 유저의 변경 전 닉네임을 반환한다.
+// 기간 내 여러 번 변경했다면 가장 처음 변경 전 닉네임을 사용한다.
+func (s *friendService) findPreviousNicknames(ctx context.Context, userIDs []primitive.ObjectID) (map[primitive.ObjectID]string, error) {
+	since := time.Now().Add(-s.nicknameHintPeriod)
+	histories, err := s.nicknameHistoryRepo.FindByUserIDsSince(ctx, userIDs, since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[primitive.ObjectID]string, len(histories))
+	for _, h := range histories {
+		if _, ok := result[h.UserID]; !ok {
+			result[h.UserID] = h.OldNickname
+		}
+	}
+	return result, nil
 }
 
 func (s *friendService) RemoveFriend(ctx context.Context, userID string, targetID string) error {
@@ -119,10 +349,178 @@ func (s *friendService) RemoveFriend(ctx context.Context, userID string, targetI
 		return domain.NewInternal("failed to delete friendship: " + err.Error())
 	}
 
+	if err := removeFromCircles(ctx, s.circleRepo, oid, targetOid); err != nil {
+		return domain.NewInternal("failed to remove from circles: " + err.Error())
+	}
+
 	return nil
 }
 
-func (s *friendService) GetRequests(ctx context.Context, userID string, requestType string) (interface{}, error) {
+const (
+	defaultSuggestionCount = 20
+	maxSuggestionCount     = 50
+	suggestionMutualNames  = 3 // 추천마다 보여줄 함께 아는 친구 수
+)
+
+// GetSuggestions 는 친구의 친구를 함께 아는 친구가 많은 순으로 추천한다.
+func (s *friendService) GetSuggestions(ctx context.Context, userID string, limit int) (*dto.FriendSuggestionListResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	if limit <= 0 {
+		limit = defaultSuggestionCount
+	}
+	limit = min(limit, maxSuggestionCount)
+
+	friendships, err := s.friendshipRepo.FindByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find friendships: " + err.Error())
+	}
+	if len(friendships) == 0 {
+		return &dto.FriendSuggestionListResponse{Suggestions: []dto.FriendSuggestionItem{}}, nil
+	}
+
+	friendIDs := make([]primitive.ObjectID, len(friendships))
+	for i, f := range friendships {
+		friendIDs[i] = f.FriendID
+	}
+
+	exclude, err := s.suggestionExclusions(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+	exclude = append(exclude, friendIDs...)
+
+	// 탈퇴 유예 중인 유저는 아래에서 빠지므로 여유 있게 조회
+	counts, err := s.friendshipRepo.CountMutualFriends(ctx, friendIDs, exclude, suggestionMutualNames, limit*2)
+	if err != nil {
+		return nil, domain.NewInternal("failed to count mutual friends: " + err.Error())
+	}
+	if len(counts) == 0 {
+		return &dto.FriendSuggestionListResponse{Suggestions: []dto.FriendSuggestionItem{}}, nil
+	}
+
+	var userIDs []primitive.ObjectID
+	for _, c := range counts {
+		userIDs = append(userIDs, c.UserID)
+		userIDs = append(userIDs, c.MutualIDs...)
+	}
+	users, err := s.userRepo.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find users: " + err.Error())
+	}
+	userMap := make(map[primitive.ObjectID]*model.User, len(users))
+	for i := range users {
+		userMap[users[i].ID] = &users[i]
+	}
+
+	items := make([]dto.FriendSuggestionItem, 0, limit)
+	for _, c := range counts {
+		if len(items) == limit {
+			break
+		}
+		u, ok := userMap[c.UserID]
+		if !ok {
+			continue
+		}
+		nicknames := make([]string, 0, len(c.MutualIDs))
+		for _, id := range c.MutualIDs {
+			if m, ok := userMap[id]; ok {
+				nicknames = append(nicknames, m.Nickname)
+			}
+		}
+		items = append(items, dto.FriendSuggestionItem{
+			User: dto.UserSearchItem{
+				UserID:   u.ID.Hex(),
+				Nickname: u.Nickname,
+				Tag:      u.Tag,
+				Avatar:   avatarURLs(s.blobStorage, u.AvatarKey),
+			},
+			MutualCount:     c.Count,
+			MutualNicknames: nicknames,
+		})
+	}
+
+	return &dto.FriendSuggestionListResponse{Suggestions: items}, nil
+}
+
+// suggestionExclusions 는 추천하지 않을 유저를 모은다.
+// 나, 요청이 오가는 중이거나 재요청 제한 중인 유저, 어느 쪽이든 차단한 유저, 추천에서 숨긴 유저.
+func (s *friendService) suggestionExclusions(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	exclude := []primitive.ObjectID{userID}
+	now := time.Now()
+
+	requests, err := s.friendRequestRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find requests: " + err.Error())
+	}
+	for _, r := range requests {
+		other := r.ReceiverID
+		if other == userID {
+			other = r.SenderID
+		}
+		// 거절된 요청도 보낸 사람에게는 대기 중으로 보임
+		open := r.Status == model.FriendRequestPending || r.Status == model.FriendRequestRejected
+		cooldown := r.RejectedAt != nil && now.Before(r.RejectedAt.Add(s.rejectCooldown))
+		if open || cooldown {
+			exclude = append(exclude, other)
+		}
+	}
+
+	blocks, err := s.blockRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find blocks: " + err.Error())
+	}
+	for _, b := range blocks {
+		exclude = append(exclude, b.BlockedID)
+	}
+
+	blockedBy, err := s.blockRepo.FindByBlockedID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find blocks: " + err.Error())
+	}
+	for _, b := range blockedBy {
+		exclude = append(exclude, b.UserID)
+	}
+
+	dismissals, err := s.dismissalRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find dismissed suggestions: " + err.Error())
+	}
+	for _, d := range dismissals {
+		exclude = append(exclude, d.DismissedID)
+	}
+
+	return exclude, nil
+}
+
+// DismissSuggestion 은 대상 유저를 친구 추천에 다시 보여주지 않는다.
+func (s *friendService) DismissSuggestion(ctx context.Context, userID string, targetID string) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	targetOid, err := primitive.ObjectIDFromHex(targetID)
+	if err != nil {
+		return domain.NewNotFound(domain.ErrUserNotFound, "invalid target user id")
+	}
+	if oid == targetOid {
+		return domain.NewBadRequest(domain.ErrBadRequest, "cannot dismiss yourself")
+	}
+
+	if err := s.dismissalRepo.Create(ctx, oid, targetOid); err != nil {
+		return domain.NewInternal("failed to dismiss suggestion: " + err.Error())
+	}
+
+	return nil
+}
+
+// GetRequests 는 status 를 생략하면 받은 요청은 대기 중인 것만, 보낸 요청은 대기·만료된 것을 반환한다.
+// 보낸 사람에게 거절된 요청은 만료될 때까지 대기 중으로 보인다.
+func (s *friendService) GetRequests(ctx context.Context, userID string, requestType string, status string) (interface{}, error) {
 	oid, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
 		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
@@ -130,16 +528,44 @@ func (s *friendService) GetRequests(ctx context.Context, userID string, requestT
 
 	switch requestType {
 	case "received":
-		return s.getReceivedRequests(ctx, oid)
+		statuses, err := parseRequestStatuses(status, []model.FriendRequestStatus{model.FriendRequestPending})
+		if err != nil {
+			return nil, err
+		}
+		return s.getReceivedRequests(ctx, oid, statuses)
 	case "sent":
-		return s.getSentRequests(ctx, oid)
+		statuses, err := parseRequestStatuses(status, []model.FriendRequestStatus{
+			model.FriendRequestPending, model.FriendRequestExpired,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s.getSentRequests(ctx, oid, statuses)
 	default:
 		return nil, domain.NewBadRequest(domain.ErrInvalidRequestType, "type must be 'sent' or 'received'")
 	}
 }
 
-func (s *friendService) getReceivedRequests(ctx context.Context, userID primitive.ObjectID) (*dto.ReceivedRequestsResponse, error) {
-	requests, err := s.friendRequestRepo.FindByReceiverID(ctx, userID, model.FriendRequestPending)
+// parseRequestStatuses 는 쉼표로 구분된 상태 목록을 읽는다 (예: "PENDING,EXPIRED").
+func parseRequestStatuses(status string, fallback []model.FriendRequestStatus) ([]model.FriendRequestStatus, error) {
+	if status == "" {
+		return fallback, nil
+	}
+
+	var statuses []model.FriendRequestStatus
+	for _, v := range strings.Split(status, ",") {
+		switch st := model.FriendRequestStatus(strings.ToUpper(strings.TrimSpace(v))); st {
+		case model.FriendRequestPending, model.FriendRequestAccepted, model.FriendRequestRejected, model.FriendRequestExpired:
+			statuses = append(statuses, st)
+		default:
+			return nil, domain.NewBadRequest(domain.ErrInvalidRequestStatus, "status must be PENDING, ACCEPTED, REJECTED or EXPIRED")
+		}
+	}
+	return statuses, nil
+}
+
+func (s *friendService) getReceivedRequests(ctx context.Context, userID primitive.ObjectID, statuses []model.FriendRequestStatus) (*dto.ReceivedRequestsResponse, error) {
+	requests, err := s.friendRequestRepo.FindByReceiverID(ctx, userID, statuses)
 	if err != nil {
 		return nil, domain.NewInternal("failed to find requests: " + err.Error())
 	}
@@ -175,7 +601,9 @@ func (s *friendService) getReceivedRequests(ctx context.Context, userID primitiv
 				UserID:   u.ID.Hex(),
 				Nickname: u.Nickname,
 				Tag:      u.Tag,
+				Avatar:   avatarURLs(s.blobStorage, u.AvatarKey),
 			},
+			Status:    string(r.Status),
 			CreatedAt: r.CreatedAt,
 		})
 	}
@@ -183,8 +611,31 @@ func (s *friendService) getReceivedRequests(ctx context.Context, userID primitiv
 	return &dto.ReceivedRequestsResponse{Requests: items}, nil
 }
 
-func (s *friendService) getSentRequests(ctx context.Context, userID primitive.ObjectID) (*dto.SentRequestsResponse, error) {
-	requests, err := s.friendRequestRepo.FindBySenderID(ctx, userID)
+// senderViewStatus 는 보낸 사람에게 보여줄 상태다. 거절은 만료될 때까지 대기 중으로 보인다.
+func senderViewStatus(status model.FriendRequestStatus) model.FriendRequestStatus {
+	if status == model.FriendRequestRejected {
+		return model.FriendRequestPending
+	}
+	return status
+}
+
+func (s *friendService) getSentRequests(ctx context.Context, userID primitive.ObjectID, statuses []model.FriendRequestStatus) (*dto.SentRequestsResponse, error) {
+	// 보낸 사람 기준 상태로 조회: PENDING 에는 거절된 요청도 포함되고, REJECTED 는 보이지 않음
+	var query []model.FriendRequestStatus
+	for _, st := range statuses {
+		switch st {
+		case model.FriendRequestRejected:
+		case model.FriendRequestPending:
+			query = append(query, model.FriendRequestPending, model.FriendRequestRejected)
+		default:
+			query = append(query, st)
+		}
+	}
+	if len(query) == 0 {
+		return &dto.SentRequestsResponse{Requests: []dto.SentRequestItem{}}, nil
+	}
+
+	requests, err := s.friendRequestRepo.FindBySenderID(ctx, userID, query)
 	if err != nil {
 		return nil, domain.NewInternal("failed to find requests: " + err.Error())
 	}
@@ -220,8 +671,9 @@ func (s *friendService) getSentRequests(ctx context.Context, userID primitive.Ob
 				UserID:   u.ID.Hex(),
 				Nickname: u.Nickname,
 				Tag:      u.Tag,
+				Avatar:   avatarURLs(s.blobStorage, u.AvatarKey),
 			},
-			Status:    string(r.Status),
+			Status:    string(senderViewStatus(r.Status)),
 			CreatedAt: r.CreatedAt,
 		})
 	}
@@ -271,6 +723,23 @@ func (s *friendService) SendRequest(ctx context.Context, userID string, req dto.
 		return nil, domain.NewConflict(domain.ErrRequestAlreadySent, "request already sent")
 	}
 
+	// 거절된 요청은 만료 전까지 보낸 사람에게 대기 중으로 보이므로 같은 에러로 응답하고,
+	// 만료된 뒤에도 거절 후 FRIEND_REQUEST_REJECT_COOLDOWN 동안은 다시 보낼 수 없음
+	now := time.Now()
+	rejected, err := s.friendRequestRepo.FindLatestRejected(ctx, senderOid, receiverOid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to check rejected request: " + err.Error())
+	}
+	if rejected != nil {
+		if rejected.Status == model.FriendRequestRejected && !rejected.SenderHidden {
+			return nil, domain.NewConflict(domain.ErrRequestAlreadySent, "request already sent")
+		}
+		if retryAt := rejected.RejectedAt.Add(s.rejectCooldown); now.Before(retryAt) {
+			return nil, domain.NewTooManyRequests(domain.ErrRequestCooldown, "request rejected recently").
+				WithRetryAfter(retryAt.Sub(now))
+		}
+	}
+
 	pendingReverse, err := s.friendRequestRepo.FindPending(ctx, receiverOid, senderOid)
 	if err != nil {
 		return nil, domain.NewInternal("failed to check request: " + err.Error())
@@ -279,24 +748,10 @@ func (s *friendService) SendRequest(ctx context.Context, userID string, req dto.
 		return nil, domain.NewConflict(domain.ErrRequestAlreadySent, "request already sent")
 	}
 
-	// 차단 확인 (양방향)
-	block, err := s.blockRepo.FindOne(ctx, senderOid, receiverOid)
-	if err != nil {
-		return nil, domain.NewInternal("failed to check block: " + err.Error())
-	}
-	if block != nil {
-		return nil, domain.NewForbidden(domain.ErrBlocked, "blocked")
+	if err := s.checkNotBlocked(ctx, senderOid, receiverOid); err != nil {
+		return nil, err
 	}
 
-	blockReverse, err := s.blockRepo.FindOne(ctx, receiverOid, senderOid)
-	if err != nil {
-		return nil, domain.NewInternal("failed to check block: " + err.Error())
-	}
-	if blockReverse != nil {
-		return nil, domain.NewForbidden(domain.ErrBlocked, "blocked")
-	}
-
-	now := time.Now()
 	friendReq := &model.FriendRequest{
 		SenderID:   senderOid,
 		ReceiverID: receiverOid,
@@ -317,6 +772,26 @@ func (s *friendService) SendRequest(ctx context.Context, userID string, req dto.
 	return &dto.SendFriendRequestResponse{RequestID: friendReq.ID.Hex()}, nil
 }
 
+// checkNotBlocked 는 두 유저 중 한쪽이라도 상대를 차단했으면 BLOCKED 를 반환한다.
+func (s *friendService) checkNotBlocked(ctx context.Context, userA, userB primitive.ObjectID) error {
+	block, err := s.blockRepo.FindOne(ctx, userA, userB)
+	if err != nil {
+		return domain.NewInternal("failed to check block: " + err.Error())
+	}
+	if block != nil {
+		return domain.NewForbidden(domain.ErrBlocked, "blocked")
+	}
+
+	blockReverse, err := s.blockRepo.FindOne(ctx, userB, userA)
+	if err != nil {
+		return domain.NewInternal("failed to check block: " + err.Error())
+	}
+	if blockReverse != nil {
+		return domain.NewForbidden(domain.ErrBlocked, "blocked")
+	}
+	return nil
+}
+
 func (s *friendService) AcceptRequest(ctx context.Context, userID string, requestID string) error {
 	oid, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
@@ -367,6 +842,17 @@ func (s *friendService) AcceptRequest(ctx context.Context, userID string, reques
 		return domain.NewInternal("failed to create friendship: " + err.Error())
 	}
 
+	// 수락한 쪽이 예전에 보냈다가 거절된 요청이 대기 중으로 남아 보이지 않게 숨김
+	reverse, err := s.friendRequestRepo.FindLatestRejected(ctx, oid, friendReq.SenderID)
+	if err != nil {
+		return domain.NewInternal("failed to check rejected request: " + err.Error())
+	}
+	if reverse != nil && reverse.Status == model.FriendRequestRejected {
+		if err := s.friendRequestRepo.HideFromSender(ctx, reverse.ID); err != nil {
+			return domain.NewInternal("failed to hide rejected request: " + err.Error())
+		}
+	}
+
 	accepter, err := s.userRepo.FindByID(ctx, oid)
 	if err == nil && accepter != nil {
 		_ = s.notifSvc.SendFriendAccept(ctx, friendReq.SenderID, accepter.Nickname)
@@ -402,7 +888,7 @@ func (s *friendService) RejectRequest(ctx context.Context, userID string, reques
 		return domain.NewBadRequest(domain.ErrRequestNotPending, "request is not pending")
 	}
 
-	if err := s.friendRequestRepo.UpdateStatus(ctx, reqOid, model.FriendRequestRejected); err != nil {
+	if err := s.friendRequestRepo.Reject(ctx, reqOid); err != nil {
 		return domain.NewInternal("failed to update request status: " + err.Error())
 	}
 
@@ -436,6 +922,14 @@ func (s *friendService) DeleteRequest(ctx context.Context, userID string, reques
 		return domain.NewBadRequest(domain.ErrBadRequest, "cannot delete accepted request")
 	}
 
+	// 거절 기록이 있는 요청은 재요청 제한을 위해 남겨 두고 보낸 사람 목록에서만 숨김
+	if friendReq.RejectedAt != nil {
+		if err := s.friendRequestRepo.HideFromSender(ctx, reqOid); err != nil {
+			return domain.NewInternal("failed to delete request: " + err.Error())
+		}
+		return nil
+	}
+
 	if err := s.friendRequestRepo.DeleteByID(ctx, reqOid); err != nil {
 		return domain.NewInternal("failed to delete request: " + err.Error())
 	}
@@ -470,10 +964,50 @@ func (s *friendService) Nudge(ctx context.Context, userID string, targetID strin
 		return domain.NewNotFound(domain.ErrUserNotFound, "user not found")
 	}
 
-	if !target.IsInVoid {
+	// 공백 상태를 공개하지 않은 친구는 공백 중이 아닌 것으로 취급
+	viewer, err := loadCircleViewer(ctx, s.circleRepo, oid, []model.User{*target})
+	if err != nil {
+		return domain.NewInternal("failed to find circles: " + err.Error())
+	}
+	if !target.IsInVoid || !viewer.canSee(target.ID, target.PrivacySettings.PresenceCircles) {
 		return domain.NewBadRequest(domain.ErrFriendNotInVoid, "friend is not in void")
 	}
 
+	now := time.Now()
+
+	// 쿨다운과 하루 제한은 조회 후 저장이라 동시에 들어온 요청은 함께 통과할 수 있음.
+	// 넘쳐도 알림 몇 개가 더 가는 정도라 잠금 없이 둠
+	// 같은 친구는 NUDGE_COOLDOWN 마다 한 번만 찌를 수 있음
+	latest, err := s.nudgeRepo.FindLatest(ctx, oid, targetOid)
+	if err != nil {
+		return domain.NewInternal("failed to find latest nudge: " + err.Error())
+	}
+	if latest != nil && now.Before(latest.CreatedAt.Add(s.nudgeCooldown)) {
+		return domain.NewTooManyRequests(domain.ErrNudgeCooldown, "nudged this friend recently").
+			WithRetryAfter(latest.CreatedAt.Add(s.nudgeCooldown).Sub(now))
+	}
+
+	// 받는 사람 기준 하루 NUDGE_DAILY_LIMIT 번까지. 다음 날이 시작되면 다시 찌를 수 있음
+	targetDay := config.CalcTargetDay(now)
+	received, err := s.nudgeRepo.CountByReceiverAndTargetDay(ctx, targetOid, targetDay)
+	if err != nil {
+		return domain.NewInternal("failed to count nudges: " + err.Error())
+	}
+	if received >= int64(s.nudgeDailyLimit) {
+		return domain.NewTooManyRequests(domain.ErrNudgeDailyLimit, "friend received too many nudges today").
+			WithRetryAfter(config.NextDayStart(now).Sub(now))
+	}
+
+	nudge := &model.Nudge{
+		SenderID:   oid,
+		ReceiverID: targetOid,
+		TargetDay:  targetDay,
+		CreatedAt:  now,
+	}
+	if err := s.nudgeRepo.Create(ctx, nudge); err != nil {
+		return domain.NewInternal("failed to create nudge: " + err.Error())
+	}
+
 	sender, err := s.userRepo.FindByID(ctx, oid)
 	if err == nil && sender != nil {
 		_ = s.notifSvc.SendFriendNudge(ctx, targetOid, sender.Nickname)
@@ -481,3 +1015,219 @@ func (s *friendService) Nudge(ctx context.Context, userID string, targetID strin
 
 	return nil
 }
+
+// GetNudges 는 오늘 밤 나를 찌른 친구를 보낸 사람별로 묶어 반환한다.
+func (s *friendService) GetNudges(ctx context.Context, userID string) (*dto.NudgeListResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	targetDay := config.CalcTargetDay(time.Now())
+	nudges, err := s.nudgeRepo.FindByReceiverAndTargetDay(ctx, oid, targetDay)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find nudges: " + err.Error())
+	}
+
+	resp := &dto.NudgeListResponse{TargetDay: targetDay, Nudges: []dto.NudgeItem{}}
+	if len(nudges) == 0 {
+		return resp, nil
+	}
+
+	// 최신순이므로 보낸 사람별 첫 기록이 마지막으로 찌른 시각
+	var senderIDs []primitive.ObjectID
+	bySender := make(map[primitive.ObjectID]*dto.NudgeItem)
+	for _, n := range nudges {
+		if item, ok := bySender[n.SenderID]; ok {
+			item.Count++
+			continue
+		}
+		senderIDs = append(senderIDs, n.SenderID)
+		bySender[n.SenderID] = &dto.NudgeItem{Count: 1, LastNudgedAt: n.CreatedAt}
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, senderIDs)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find users: " + err.Error())
+	}
+
+	userMap := make(map[primitive.ObjectID]*model.User, len(users))
+	for i := range users {
+		userMap[users[i].ID] = &users[i]
+	}
+
+	for _, id := range senderIDs {
+		u, ok := userMap[id]
+		if !ok {
+			continue
+		}
+		item := bySender[id]
+		item.Sender = dto.UserSearchItem{
+			UserID:   u.ID.Hex(),
+			Nickname: u.Nickname,
+			Tag:      u.Tag,
+			Avatar:   avatarURLs(s.blobStorage, u.AvatarKey),
+		}
+		resp.Nudges = append(resp.Nudges, *item)
+	}
+
+	return resp, nil
+}
+
+// CreateInvite 는 친구 요청 없이 바로 친구가 되는 초대 코드를 만든다.
+func (s *friendService) CreateInvite(ctx context.Context, userID string, req dto.CreateFriendInviteRequest) (*dto.FriendInviteResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	ttl := s.inviteTTL
+	if req.ExpiresInHours > 0 {
+		ttl = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+	maxUses := s.inviteMaxUses
+	if req.MaxUses > 0 {
+		maxUses = req.MaxUses
+	}
+
+	now := time.Now()
+	const maxRetries = 5
+	for i := 0; i < maxRetries; i++ {
+		code, err := generateInviteCode()
+		if err != nil {
+			return nil, domain.NewInternal("failed to generate invite code: " + err.Error())
+		}
+
+		invite := &model.FriendInvite{
+			Code:      code,
+			UserID:    oid,
+			MaxUses:   maxUses,
+			ExpiresAt: now.Add(ttl),
+			CreatedAt: now,
+		}
+		if err := s.friendInviteRepo.Create(ctx, invite); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				continue
+			}
+			return nil, domain.NewInternal("failed to create invite: " + err.Error())
+		}
+
+		resp := &dto.FriendInviteResponse{
+			Code:      invite.Code,
+			MaxUses:   invite.MaxUses,
+			ExpiresAt: invite.ExpiresAt,
+		}
+		if s.inviteBaseURL != "" {
+			url := s.inviteBaseURL + "/" + invite.Code
+			resp.URL = &url
+		}
+		return resp, nil
+	}
+	return nil, domain.NewInternal("failed to generate unique invite code after retries")
+}
+
+// RedeemInvite 는 초대 코드로 초대한 유저와 바로 친구가 된다. 차단 확인은 SendRequest 와 같다.
+func (s *friendService) RedeemInvite(ctx context.Context, userID string, code string) (*dto.RedeemFriendInviteResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	invite, err := s.friendInviteRepo.FindByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, domain.NewInternal("failed to find invite: " + err.Error())
+	}
+	if invite == nil {
+		return nil, domain.NewNotFound(domain.ErrInviteNotFound, "invite not found")
+	}
+
+	now := time.Now()
+	if !now.Before(invite.ExpiresAt) {
+		return nil, domain.NewBadRequest(domain.ErrInviteExpired, "invite expired")
+	}
+	if invite.Uses >= invite.MaxUses {
+		return nil, domain.NewBadRequest(domain.ErrInviteExhausted, "invite has no uses left")
+	}
+	if invite.UserID == oid {
+		return nil, domain.NewBadRequest(domain.ErrBadRequest, "cannot redeem your own invite")
+	}
+
+	// 탈퇴했거나 탈퇴 유예 중인 유저의 초대는 없는 초대로 취급
+	inviter, err := s.userRepo.FindByID(ctx, invite.UserID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find user: " + err.Error())
+	}
+	if inviter == nil {
+		return nil, domain.NewNotFound(domain.ErrInviteNotFound, "invite not found")
+	}
+
+	existing, err := s.friendshipRepo.FindOne(ctx, oid, inviter.ID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to check friendship: " + err.Error())
+	}
+	if existing != nil {
+		return nil, domain.NewConflict(domain.ErrAlreadyFriends, "already friends")
+	}
+
+	if err := s.checkNotBlocked(ctx, oid, inviter.ID); err != nil {
+		return nil, err
+	}
+
+	used, err := s.friendInviteRepo.Use(ctx, invite.ID, now)
+	if err != nil {
+		return nil, domain.NewInternal("failed to use invite: " + err.Error())
+	}
+	if !used {
+		// 확인한 뒤 다른 사람이 마지막 횟수를 사용했거나 그 사이 만료됨
+		return nil, domain.NewBadRequest(domain.ErrInviteExhausted, "invite has no uses left")
+	}
+
+	// 두 유저 사이에 남아 있던 친구 요청은 더 이상 의미가 없으므로 정리
+	if err := s.friendRequestRepo.DeleteByUserPair(ctx, oid, inviter.ID); err != nil {
+		return nil, domain.NewInternal("failed to delete friend requests: " + err.Error())
+	}
+
+	f1 := &model.Friendship{
+		UserID:    inviter.ID,
+		FriendID:  oid,
+		CreatedAt: now,
+	}
+	f2 := &model.Friendship{
+		UserID:    oid,
+		FriendID:  inviter.ID,
+		CreatedAt: now,
+	}
+
+	if err := s.friendshipRepo.Create(ctx, f1); err != nil {
+		return nil, domain.NewInternal("failed to create friendship: " + err.Error())
+	}
+	if err := s.friendshipRepo.Create(ctx, f2); err != nil {
+		return nil, domain.NewInternal("failed to create friendship: " + err.Error())
+	}
+
+	redeemer, err := s.userRepo.FindByID(ctx, oid)
+	if err == nil && redeemer != nil {
+		_ = s.notifSvc.SendFriendInvite(ctx, inviter.ID, redeemer.Nickname)
+	}
+
+	return &dto.RedeemFriendInviteResponse{
+		Friend: dto.UserSearchItem{
+			UserID:   inviter.ID.Hex(),
+			Nickname: inviter.Nickname,
+			Tag:      inviter.Tag,
+			Avatar:   avatarURLs(s.blobStorage, inviter.AvatarKey),
+		},
+	}, nil
+}
+
+func generateInviteCode() (string, error) {
+	b := make([]byte, inviteCodeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(inviteCodeCharset))))
+		if err != nil {
+			return "", err
+		}
+		b[i] = inviteCodeCharset[n.Int64()]
+	}
+	return string(b), nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"dangbamgong-backend/internal/dto"
+)
+
+func TestFriendCursorLess(t *testing.T) {
+	base := friendCursor{A: 1, B: 2, Text: "m", ID: "5"}
+
+	tests := []struct {
+		name  string
+		other friendCursor
+		want  bool
+	}{
+		{name: "same", other: base, want: false},
+		{name: "a wins over b", other: friendCursor{A: 2, B: 0, Text: "a", ID: "0"}, want: true},
+		{name: "smaller a", other: friendCursor{A: 0, B: 9, Text: "z", ID: "9"}, want: false},
+		{name: "b breaks tie", other: friendCursor{A: 1, B: 3, Text: "a", ID: "0"}, want: true},
+		{name: "text breaks tie", other: friendCursor{A: 1, B: 2, Text: "n", ID: "0"}, want: true},
+		{name: "id breaks tie", other: friendCursor{A: 1, B: 2, Text: "m", ID: "6"}, want: true},
+		{name: "smaller id", other: friendCursor{A: 1, B: 2, Text: "m", ID: "4"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.less(tt.other); got != tt.want {
+				t.Errorf("less() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testFriends 는 정렬마다 순서가 다르게 나오는 친구 목록이다.
+func testFriends() []dto.FriendItem {
+	at := func(hour int) *time.Time {
+		t := time.Date(2026, 1, 1, hour, 0, 0, 0, time.UTC)
+		return &t
+	}
+	since := func(day int) time.Time {
+		return time.Date(2025, 12, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	return []dto.FriendItem{
+		{UserID: "1", Nickname: "dana", LastVoidEndedAt: at(3), CreatedAt: since(1)},
+		{UserID: "2", Nickname: "Bora", IsInVoid: true, LastVoidEndedAt: at(1), CreatedAt: since(4)},
+		{UserID: "3", Nickname: "cho", CreatedAt: since(2)},
+		{UserID: "4", Nickname: "ahn", LastVoidEndedAt: at(5), CreatedAt: since(5)},
+		{UserID: "5", Nickname: "eun", IsInVoid: true, CreatedAt: since(3)},
+		{UserID: "6", Nickname: "bora", LastVoidEndedAt: at(3), CreatedAt: since(3)},
+	}
+}
+
+func TestFriendSortKey(t *testing.T) {
+	tests := []struct {
+		sort string
+		want []string
+	}{
+		// 친구가 된 날짜 최신순, 같으면 ID 순
+		{sort: friendSortFriendship, want: []string{"4", "2", "5", "6", "3", "1"}},
+		// 공백 중인 친구 먼저, 그 안에서 최근에 마친 순, 공백 기록이 없으면 뒤로
+		{sort: friendSortInVoid, want: []string{"2", "5", "4", "6", "1", "3"}},
+		// 최근에 마친 순, 같으면 닉네임순, 공백 기록이 없으면 뒤로
+		{sort: friendSortRecent, want: []string{"4", "6", "1", "2", "3", "5"}},
+		// 대소문자 구분 없이 닉네임순, 같으면 ID 순
+		{sort: friendSortNickname, want: []string{"4", "2", "6", "3", "1", "5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			items := testFriends()
+			page, next := pageFriends(items, tt.sort, nil, len(items))
+			if next != nil {
+				t.Errorf("next cursor = %q, want nil", *next)
+			}
+			if got := friendIDs(page); !slices.Equal(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageFriends(t *testing.T) {
+	for _, sortBy := range []string{friendSortFriendship, friendSortInVoid, friendSortRecent, friendSortNickname} {
+		t.Run(sortBy, func(t *testing.T) {
+			want, _ := pageFriends(testFriends(), sortBy, nil, 100)
+
+			// 커서를 따라가면 전체 순서를 빠짐없이 한 번씩 받아야 함
+			var got []string
+			var after *friendCursor
+			for range len(want) {
+				page, next := pageFriends(testFriends(), sortBy, after, 4)
+				got = append(got, friendIDs(page)...)
+				if next == nil {
+					break
+				}
+				after = &friendCursor{}
+				if err := decodeCursor(*next, after); err != nil {
+					t.Fatalf("decodeCursor() error: %v", err)
+				}
+				if after.Sort != sortBy {
+					t.Fatalf("cursor sort = %q, want %q", after.Sort, sortBy)
+				}
+			}
+			if !slices.Equal(got, friendIDs(want)) {
+				t.Errorf("paged order = %v, want %v", got, friendIDs(want))
+			}
+		})
+	}
+}
+
+func TestPageFriendsResume(t *testing.T) {
+	items := testFriends()
+	first, next := pageFriends(items, friendSortNickname, nil, 2)
+	if got := friendIDs(first); !slices.Equal(got, []string{"4", "2"}) {
+		t.Fatalf("first page = %v", got)
+	}
+	if next == nil {
+		t.Fatal("next cursor = nil")
+	}
+	var after friendCursor
+	if err := decodeCursor(*next, &after); err != nil {
+		t.Fatalf("decodeCursor() error: %v", err)
+	}
+
+	// 커서 위치의 친구가 사라져도 그다음 친구부터 이어짐
+	removed := slices.DeleteFunc(testFriends(), func(item dto.FriendItem) bool { return item.UserID == "2" })
+	page, _ := pageFriends(removed, friendSortNickname, &after, 2)
+	if got := friendIDs(page); !slices.Equal(got, []string{"6", "3"}) {
+		t.Errorf("after removal = %v, want [6 3]", got)
+	}
+
+	// 커서 앞에 새 친구가 끼어들어도 이미 받은 위치 뒤부터 이어짐
+	added := append(testFriends(), dto.FriendItem{UserID: "7", Nickname: "aaa"})
+	page, _ = pageFriends(added, friendSortNickname, &after, 2)
+	if got := friendIDs(page); !slices.Equal(got, []string{"6", "3"}) {
+		t.Errorf("after insertion = %v, want [6 3]", got)
+	}
+
+	// 마지막 항목 뒤의 커서는 빈 페이지
+	last := friendSortKey(friendSortNickname, &dto.FriendItem{UserID: "9", Nickname: "zzz"})
+	page, next = pageFriends(testFriends(), friendSortNickname, &last, 2)
+	if len(page) != 0 || next != nil {
+		t.Errorf("past end = %v, %v, want empty page and nil cursor", friendIDs(page), next)
+	}
+}
+
+func friendIDs(items []dto.FriendItem) []string {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.UserID
+	}
+	return ids
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"unicode/utf8"
+
+	"dangbamgong-backend/internal/domain"
+	"dangbamgong-backend/internal/filter"
+)
+
+// validateNickname 은 닉네임 길이와 금칙어/예약어 포함 여부를 확인한다.
+func validateNickname(nicknameFilter *filter.NicknameFilter, nickname string) error {
+	length := utf8.RuneCountInString(nickname)
+	if length < 3 || length > 15 {
+		return domain.NewBadRequest(domain.ErrInvalidNickname, "nickname must be 3-15 characters")
+	}
+
+	if !nicknameFilter.IsAllowed(nickname) {
+		return domain.NewBadRequest(domain.ErrNicknameNotAllowed, "nickname contains a banned word or reserved name")
+	}
+
+	return nil
+}
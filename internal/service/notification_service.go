@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"log"
+	"strconv"
 	"time"
 
 	"dangbamgong-backend/internal/domain"
@@ -19,6 +20,11 @@ type NotificationService interface {
 	SendFriendRequest(ctx context.Context, receiverID primitive.ObjectID, senderNickname string) error
 	SendFriendAccept(ctx context.Context, originalSenderID primitive.ObjectID, accepterNickname string) error
 	SendFriendNudge(ctx context.Context, targetID primitive.ObjectID, senderNickname string) error
+	SendFriendInvite(ctx context.Context, inviterID primitive.ObjectID, redeemerNickname string) error
+	SendFriendRequestExpired(ctx context.Context, senderID primitive.ObjectID, receiverNickname string) error
+	SendSessionReactions(ctx context.Context, ownerID primitive.ObjectID, reactorNickname string, count int) error
+	SendVoidRoomInvite(ctx context.Context, inviteeID primitive.ObjectID, inviterNickname string, roomID primitive.ObjectID) error
+	SendVoidRoomJoin(ctx context.Context, memberID primitive.ObjectID, joinerNickname string, roomID primitive.ObjectID) error
 
 	GetNotifications(ctx context.Context, userID string, limit int, offset int) (*dto.NotificationListResponse, error)
 	MarkAsRead(ctx context.Context, userID string, notifID string) error
@@ -88,9 +94,9 @@ func (s *notificationService) isPushEnabled(ctx context.Context, userID primitiv
 	switch notifType {
 	case model.NotifVoidReminder:
 		return user.NotificationSettings.VoidReminder
-	case model.NotifFriendRequest, model.NotifFriendAccept:
+	case model.NotifFriendRequest, model.NotifFriendAccept, model.NotifFriendInvite, model.NotifFriendExpired:
 		return user.NotificationSettings.FriendRequest
-	case model.NotifFriendNudge:
+	case model.NotifFriendNudge, model.NotifSessionReact, model.NotifVoidRoomInvite, model.NotifVoidRoomJoin:
 		return user.NotificationSettings.FriendNudge
 	default:
 		return false
@@ -137,6 +143,62 @@ func (s *notificationService) SendFriendNudge(ctx context.Context, targetID prim
 	return nil
 }
 
+func (s *notificationService) SendFriendInvite(ctx context.Context, inviterID primitive.ObjectID, redeemerNickname string) error {
+	pushEnabled := s.isPushEnabled(ctx, inviterID, model.NotifFriendInvite)
+	s.sendNotification(ctx, inviterID, model.NotifFriendInvite,
+		"친구 추가",
+		redeemerNickname+"님이 초대 링크로 친구가 되었어요.",
+		map[string]string{"redeemerNickname": redeemerNickname}, pushEnabled,
+	)
+	return nil
+}
+
+func (s *notificationService) SendFriendRequestExpired(ctx context.Context, senderID primitive.ObjectID, receiverNickname string) error {
+	pushEnabled := s.isPushEnabled(ctx, senderID, model.NotifFriendExpired)
+	s.sendNotification(ctx, senderID, model.NotifFriendExpired,
+		"친구 요청 만료",
+		receiverNickname+"님에게 보낸 친구 요청이 만료되었어요.",
+		map[string]string{"receiverNickname": receiverNickname}, pushEnabled,
+	)
+	return nil
+}
+
+// SendSessionReactions 는 모아 둔 반응을 한 번에 알린다. count 는 반응한 친구 수.
+func (s *notificationService) SendSessionReactions(ctx context.Context, ownerID primitive.ObjectID, reactorNickname string, count int) error {
+	body := reactorNickname + "님이 공백 기록에 반응했어요."
+	if count > 1 {
+		body = reactorNickname + "님 외 " + strconv.Itoa(count-1) + "명이 공백 기록에 반응했어요."
+	}
+
+	pushEnabled := s.isPushEnabled(ctx, ownerID, model.NotifSessionReact)
+	s.sendNotification(ctx, ownerID, model.NotifSessionReact,
+		"공백 반응",
+		body,
+		map[string]string{"reactorNickname": reactorNickname, "count": strconv.Itoa(count)}, pushEnabled,
+	)
+	return nil
+}
+
+func (s *notificationService) SendVoidRoomInvite(ctx context.Context, inviteeID primitive.ObjectID, inviterNickname string, roomID primitive.ObjectID) error {
+	pushEnabled := s.isPushEnabled(ctx, inviteeID, model.NotifVoidRoomInvite)
+	s.sendNotification(ctx, inviteeID, model.NotifVoidRoomInvite,
+		"함께 공백",
+		inviterNickname+"님이 함께 공백하자고 초대했어요.",
+		map[string]string{"inviterNickname": inviterNickname, "roomId": roomID.Hex()}, pushEnabled,
+	)
+	return nil
+}
+
+func (s *notificationService) SendVoidRoomJoin(ctx context.Context, memberID primitive.ObjectID, joinerNickname string, roomID primitive.ObjectID) error {
+	pushEnabled := s.isPushEnabled(ctx, memberID, model.NotifVoidRoomJoin)
+	s.sendNotification(ctx, memberID, model.NotifVoidRoomJoin,
+		"함께 공백",
+		joinerNickname+"님이 공백 방에 들어왔어요.",
+		map[string]string{"joinerNickname": joinerNickname, "roomId": roomID.Hex()}, pushEnabled,
+	)
+	return nil
+}
+
 func (s *notificationService) GetNotifications(ctx context.Context, userID string, limit int, offset int) (*dto.NotificationListResponse, error) {
 	oid, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
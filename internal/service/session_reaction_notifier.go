@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const sessionReactionNotifyBatchSize = 500
+
+// SessionReactionNotifier 는 공백 기록에 달린 반응을 모아 세션 주인에게 한 번에 알린다.
+// 반응할 때마다 알림을 보내지 않고, REACTION_NOTIFY_INTERVAL(기본 5분)마다 새 반응을 묶어 보낸다.
+type SessionReactionNotifier struct {
+	reactionRepo repository.SessionReactionRepository
+	userRepo     repository.UserRepository
+	notifSvc     NotificationService
+}
+
+func NewSessionReactionNotifier(srr repository.SessionReactionRepository, ur repository.UserRepository, ns NotificationService) *SessionReactionNotifier {
+	return &SessionReactionNotifier{
+		reactionRepo: srr,
+		userRepo:     ur,
+		notifSvc:     ns,
+	}
+}
+
+// NotifyPending 은 아직 알리지 않은 반응을 주인별로 묶어 알리고 알림을 보낸 주인 수를 반환한다.
+func (n *SessionReactionNotifier) NotifyPending(ctx context.Context) int {
+	notified := 0
+	for {
+		pending, err := n.reactionRepo.FindUnnotified(ctx, sessionReactionNotifyBatchSize)
+		if err != nil {
+			log.Printf("[REACTION] failed to find pending reactions: %v\n", err)
+			return notified
+		}
+		if len(pending) == 0 {
+			return notified
+		}
+
+		// 알림보다 먼저 표시해 실패 시 같은 알림이 반복되지 않도록 하고,
+		// 다른 인스턴스와 겹치면 이번에 가져온 반응만 알림
+		ids := make([]primitive.ObjectID, len(pending))
+		for i, r := range pending {
+			ids[i] = r.ID
+		}
+		reactions, err := n.reactionRepo.ClaimNotified(ctx, ids, primitive.NewObjectID())
+		if err != nil {
+			log.Printf("[REACTION] failed to mark reactions notified: %v\n", err)
+			return notified
+		}
+
+		// 주인별로 반응한 친구를 모음. 같은 친구가 여러 세션에 반응해도 한 명으로 셈
+		var owners []primitive.ObjectID
+		reactors := make(map[primitive.ObjectID][]primitive.ObjectID)
+		for _, r := range reactions {
+			if _, ok := reactors[r.OwnerID]; !ok {
+				owners = append(owners, r.OwnerID)
+			}
+			if !containsObjectID(reactors[r.OwnerID], r.UserID) {
+				reactors[r.OwnerID] = append(reactors[r.OwnerID], r.UserID)
+			}
+		}
+
+		for _, ownerID := range owners {
+			userIDs := reactors[ownerID]
+			first, err := n.userRepo.FindByID(ctx, userIDs[0])
+			if err != nil || first == nil {
+				continue
+			}
+			_ = n.notifSvc.SendSessionReactions(ctx, ownerID, first.Nickname, len(userIDs))
+			notified++
+		}
+
+		if len(pending) < sessionReactionNotifyBatchSize {
+			return notified
+		}
+	}
+}
+
+// Watch 는 REACTION_NOTIFY_INTERVAL(기본 5분)마다 새 반응을 알린다.
+func (n *SessionReactionNotifier) Watch(ctx context.Context) {
+	interval := config.GetDuration("REACTION_NOTIFY_INTERVAL", 5*time.Minute)
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if sent := n.NotifyPending(ctx); sent > 0 {
+				log.Printf("[REACTION] sent reaction notifications to %d users\n", sent)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
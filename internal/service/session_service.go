@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const sessionCacheMaxEntries = 10000
+
+type SessionService interface {
+	// ValidateSession 은 액세스 토큰의 세션이 유효한지 확인한다. (middleware.SessionValidator)
+	ValidateSession(ctx context.Context, userID string, sessionID string) error
+	GetSessions(ctx context.Context, userID string, sessionID string) (*dto.SessionListResponse, error)
+	Logout(ctx context.Context, userID string, sessionID string) error
+	LogoutOthers(ctx context.Context, userID string, sessionID string) error
+	Revoke(ctx context.Context, sessionID primitive.ObjectID) error
+	RevokeAll(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type sessionCacheEntry struct {
+	userID    string
+	err       error
+	expiresAt time.Time
+}
+
+// sessionService 는 폐기된 토큰이 반복해서 DB 를 조회하지 않도록 "세션 없음·폐기됨" 결과만 SESSION_CACHE_TTL 동안 캐싱한다.
+// 폐기는 되돌릴 수 없으므로 캐싱해도 안전하다. 유효한 세션과 정지·탈퇴 유예 같은 계정 상태는 캐싱하지 않으므로,
+// 다른 인스턴스에서 폐기한 세션이나 DB 에서 직접 정지한 계정은 다음 요청부터 막히고, 정지가 풀린 계정도 바로 다시 쓸 수 있다.
+type sessionService struct {
+	sessionRepo      repository.SessionRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	userRepo         repository.UserRepository
+	cacheTTL         time.Duration
+
+	mu    sync.Mutex
+	cache map[string]sessionCacheEntry
+}
+
+func NewSessionService(
+	sr repository.SessionRepository,
+	rtr repository.RefreshTokenRepository,
+	ur repository.UserRepository,
+) SessionService {
+	return &sessionService{
+		sessionRepo:      sr,
+		refreshTokenRepo: rtr,
+		userRepo:         ur,
+		cacheTTL:         config.GetDuration("SESSION_CACHE_TTL", 30*time.Second),
+		cache:            make(map[string]sessionCacheEntry),
+	}
+}
+
+func (s *sessionService) ValidateSession(ctx context.Context, userID string, sessionID string) error {
+	now := time.Now()
+
+	s.mu.Lock()
+	entry, ok := s.cache[sessionID]
+	s.mu.Unlock()
+	if ok && entry.userID == userID && now.Before(entry.expiresAt) {
+		return entry.err
+	}
+
+	oid, err := s.checkSession(ctx, userID, sessionID)
+	if err != nil {
+		var appErr *domain.AppError
+		if errors.As(err, &appErr) && appErr.StatusCode < http.StatusInternalServerError {
+			// DB 오류는 캐싱하지 않음
+			s.mu.Lock()
+			if len(s.cache) >= sessionCacheMaxEntries {
+				s.evictExpiredLocked(now)
+			}
+			s.cache[sessionID] = sessionCacheEntry{userID: userID, err: err, expiresAt: now.Add(s.cacheTTL)}
+			s.mu.Unlock()
+		}
+		return err
+	}
+
+	return s.checkUser(ctx, oid)
+}
+
+// checkSession 은 세션이 있고 폐기되지 않았는지 확인한다. 여기서 거부된 결과만 캐싱한다.
+func (s *sessionService) checkSession(ctx context.Context, userID string, sessionID string) (primitive.ObjectID, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return oid, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+	sid, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return oid, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid session")
+	}
+
+	session, err := s.sessionRepo.FindByID(ctx, sid)
+	if err != nil {
+		return oid, domain.NewInternal("failed to find session: " + err.Error())
+	}
+	if session == nil || session.UserID != oid || session.RevokedAt != nil {
+		return oid, domain.NewUnauthorized(domain.ErrUnauthorized, "session revoked")
+	}
+	return oid, nil
+}
+
+// checkUser 는 계정이 정지되거나 탈퇴 유예 중이 아닌지 확인한다. 풀리면 바로 반영되도록 캐싱하지 않는다.
+func (s *sessionService) checkUser(ctx context.Context, oid primitive.ObjectID) error {
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil {
+		return domain.NewInternal("failed to find user: " + err.Error())
+	}
+	if user == nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+	if user.SuspendedAt != nil {
+		return domain.NewForbidden(domain.ErrAccountSuspended, "account suspended")
+	}
+	return nil
+}
+
+func (s *sessionService) GetSessions(ctx context.Context, userID string, sessionID string) (*dto.SessionListResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	sessions, err := s.sessionRepo.FindActiveByUserID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find sessions: " + err.Error())
+	}
+
+	items := make([]dto.SessionItem, len(sessions))
+	for i, session := range sessions {
+		items[i] = dto.SessionItem{
+			SessionID:  session.ID.Hex(),
+			Provider:   string(session.Provider),
+			DeviceName: session.DeviceName,
+			Platform:   session.Platform,
+			AppVersion: session.AppVersion,
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastSeenAt,
+			IsCurrent:  session.ID.Hex() == sessionID,
+		}
+	}
+
+	return &dto.SessionListResponse{Sessions: items}, nil
+}
+
+func (s *sessionService) Logout(ctx context.Context, userID string, sessionID string) error {
+	sid, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid session")
+	}
+
+	return s.Revoke(ctx, sid)
+}
+
+// Revoke 는 세션과 그 refresh token family 를 폐기해 액세스 토큰도 바로 쓸 수 없게 한다.
+func (s *sessionService) Revoke(ctx context.Context, sessionID primitive.ObjectID) error {
+	if err := s.sessionRepo.Revoke(ctx, sessionID); err != nil {
+		return domain.NewInternal("failed to revoke session: " + err.Error())
+	}
+	if err := s.refreshTokenRepo.RevokeFamily(ctx, sessionID); err != nil {
+		return domain.NewInternal("failed to revoke refresh tokens: " + err.Error())
+	}
+
+	s.invalidate(sessionID.Hex())
+	return nil
+}
+
+func (s *sessionService) LogoutOthers(ctx context.Context, userID string, sessionID string) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+	sid, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid session")
+	}
+
+	revoked, err := s.sessionRepo.RevokeOthers(ctx, oid, sid)
+	if err != nil {
+		return domain.NewInternal("failed to revoke sessions: " + err.Error())
+	}
+	if len(revoked) == 0 {
+		return nil
+	}
+
+	if err := s.refreshTokenRepo.RevokeFamilies(ctx, revoked); err != nil {
+		return domain.NewInternal("failed to revoke refresh tokens: " + err.Error())
+	}
+
+	ids := make([]string, len(revoked))
+	for i, id := range revoked {
+		ids[i] = id.Hex()
+	}
+	s.invalidate(ids...)
+	return nil
+}
+
+// RevokeAll 은 탈퇴 시 유저의 모든 세션과 refresh token 을 삭제한다.
+func (s *sessionService) RevokeAll(ctx context.Context, userID primitive.ObjectID) error {
+	if err := s.sessionRepo.DeleteByUserID(ctx, userID); err != nil {
+		return domain.NewInternal("failed to delete sessions: " + err.Error())
+	}
+	if err := s.refreshTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+		return domain.NewInternal("failed to delete refresh tokens: " + err.Error())
+	}
+
+	s.mu.Lock()
+	for sid, entry := range s.cache {
+		if entry.userID == userID.Hex() {
+			delete(s.cache, sid)
+		}
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *sessionService) invalidate(sessionIDs ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sid := range sessionIDs {
+		delete(s.cache, sid)
+	}
+}
+
+func (s *sessionService) evictExpiredLocked(now time.Time) {
+	for sid, entry := range s.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(s.cache, sid)
+		}
+	}
+	// 만료된 항목이 없을 정도로 많으면 전부 비움
+	if len(s.cache) >= sessionCacheMaxEntries {
+		s.cache = make(map[string]sessionCacheEntry)
+	}
+}
@@ -2,33 +2,70 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"regexp"
+	"strings"
 	"time"
-	"unicode/utf8"
 
+	"dangbamgong-backend/internal/config"
 	"dangbamgong-backend/internal/domain"
 	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/filter"
+	"dangbamgong-backend/internal/imaging"
 	"dangbamgong-backend/internal/model"
 	"dangbamgong-backend/internal/repository"
+	"dangbamgong-backend/internal/storage"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
+var tagPattern = regexp.MustCompile(`^[A-Z0-9]{4,12}$`)
+
+// 프로필의 친구 관계 상태
+const (
+	friendshipStatusSelf            = "SELF"
+	friendshipStatusFriend          = "FRIEND"
+	friendshipStatusPendingSent     = "PENDING_SENT"
+	friendshipStatusPendingReceived = "PENDING_RECEIVED"
+	friendshipStatusBlocked         = "BLOCKED"
+	friendshipStatusNone            = "NONE"
+)
+
+// 연속 공백 일수는 최대 이 기간까지만 계산
+const streakLookbackDays = 365
+
 type UserService interface {
 	GetMe(ctx context.Context, userID string) (*dto.UserMeResponse, error)
 	UpdateSettings(ctx context.Context, userID string, req dto.UpdateSettingsRequest) (*dto.UpdateSettingsResponse, error)
+	UpdatePrivacy(ctx context.Context, userID string, req dto.UpdatePrivacyRequest) (*dto.PrivacySettings, error)
+	GetProfile(ctx context.Context, userID string, targetID string) (*dto.UserProfileResponse, error)
 	Search(ctx context.Context, userID string, tagPrefix string) (*dto.UserSearchResponse, error)
 	GetBlocks(ctx context.Context, userID string) (*dto.BlockListResponse, error)
 	Block(ctx context.Context, userID string, targetID string) error
 	Unblock(ctx context.Context, userID string, targetID string) error
 	ChangeNickname(ctx context.Context, userID string, req dto.ChangeNicknameRequest) (*dto.ChangeNicknameResponse, error)
+	ChangeTag(ctx context.Context, userID string, req dto.ChangeTagRequest) (*dto.ChangeTagResponse, error)
+	UploadAvatar(ctx context.Context, userID string, data []byte) (*dto.AvatarResponse, error)
+	DeleteAvatar(ctx context.Context, userID string) error
 }
 
 type userService struct {
-	userRepo          repository.UserRepository
-	blockRepo         repository.BlockRepository
-	friendshipRepo    repository.FriendshipRepository
-	friendRequestRepo repository.FriendRequestRepository
+	userRepo             repository.UserRepository
+	blockRepo            repository.BlockRepository
+	friendshipRepo       repository.FriendshipRepository
+	friendRequestRepo    repository.FriendRequestRepository
+	voidSessionRepo      repository.VoidSessionRepository
+	nicknameHistoryRepo  repository.NicknameHistoryRepository
+	tagReservationRepo   repository.TagReservationRepository
+	circleRepo           repository.CircleRepository
+	nicknameFilter       *filter.NicknameFilter
+	blobStorage          storage.BlobStorage
+	nicknameCooldown     time.Duration
+	tagCooldown          time.Duration // 0 이하이면 한 번만 변경 가능
+	tagReservationPeriod time.Duration
 }
 
 func NewUserService(
@@ -36,12 +73,27 @@ func NewUserService(
 	br repository.BlockRepository,
 	fr repository.FriendshipRepository,
 	frr repository.FriendRequestRepository,
+	vr repository.VoidSessionRepository,
+	nhr repository.NicknameHistoryRepository,
+	trr repository.TagReservationRepository,
+	cr repository.CircleRepository,
+	nf *filter.NicknameFilter,
+	bs storage.BlobStorage,
 ) UserService {
 	return &userService{
-		userRepo:          ur,
-		blockRepo:         br,
-		friendshipRepo:    fr,
-		friendRequestRepo: frr,
+		userRepo:             ur,
+		blockRepo:            br,
+		friendshipRepo:       fr,
+		friendRequestRepo:    frr,
+		voidSessionRepo:      vr,
+		nicknameHistoryRepo:  nhr,
+		tagReservationRepo:   trr,
+		circleRepo:           cr,
+		nicknameFilter:       nf,
+		blobStorage:          bs,
+		nicknameCooldown:     config.GetDuration("NICKNAME_CHANGE_COOLDOWN", 30*24*time.Hour),
+		tagCooldown:          config.GetDuration("TAG_CHANGE_COOLDOWN", 90*24*time.Hour),
+		tagReservationPeriod: config.GetDuration("TAG_RESERVATION_PERIOD", 30*24*time.Hour),
 	}
 }
 
@@ -56,10 +108,16 @@ func (s *userService) GetMe(ctx context.Context, userID string) (*dto.UserMeResp
 		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
 	}
 
+	nextChangeAt, err := s.nextNicknameChangeAt(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find nickname history: " + err.Error())
+	}
+
 	return &dto.UserMeResponse{
 		ID:                   user.ID.Hex(),
 		Tag:                  user.Tag,
 		Nickname:             user.Nickname,
+		Avatar:               avatarURLs(s.blobStorage, user.AvatarKey),
 		IsInVoid:             user.IsInVoid,
 		CurrentVoidStartedAt: user.CurrentVoidStartedAt,
 		NotificationSettings: dto.NotificationSettings{
@@ -68,6 +126,8 @@ func (s *userService) GetMe(ctx context.Context, userID string) (*dto.UserMeResp
 			FriendRequest: user.NotificationSettings.FriendRequest,
 			FriendNudge:   user.NotificationSettings.FriendNudge,
 		},
+		PrivacySettings:      privacySettingsResponse(user.PrivacySettings, statsVisibility(user)),
+		NicknameChangeableAt: nextChangeAt,
 	}, nil
 }
 
@@ -111,6 +171,296 @@ func (s *userService) UpdateSettings(ctx context.Context, userID string, req dto
 	}, nil
 }
 
+func (s *userService) UpdatePrivacy(ctx context.Context, userID string, req dto.UpdatePrivacyRequest) (*dto.PrivacySettings, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil || user == nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+
+	settings := user.PrivacySettings
+	settings.StatsVisibility = statsVisibility(user)
+	if req.StatsVisibility != nil {
+		switch v := model.StatsVisibility(*req.StatsVisibility); v {
+		case model.StatsVisibilityEveryone, model.StatsVisibilityFriends, model.StatsVisibilityNobody:
+			settings.StatsVisibility = v
+		default:
+			return nil, domain.NewBadRequest(domain.ErrInvalidPrivacySetting, "invalid stats visibility")
+		}
+	}
+
+	if req.ShareActivities != nil {
+		settings.ShareActivities = *req.ShareActivities
+	}
+
+	if req.StatsCircles != nil || req.PresenceCircles != nil || req.SessionCircles != nil {
+		circles, err := s.circleRepo.FindByUserID(ctx, oid)
+		if err != nil {
+			return nil, domain.NewInternal("failed to find circles: " + err.Error())
+		}
+		for _, field := range []struct {
+			req *[]string
+			dst *[]primitive.ObjectID
+		}{
+			{req.StatsCircles, &settings.StatsCircles},
+			{req.PresenceCircles, &settings.PresenceCircles},
+			{req.SessionCircles, &settings.SessionCircles},
+		} {
+			if field.req == nil {
+				continue
+			}
+			ids, err := parseOwnCircleIDs(*field.req, circles)
+			if err != nil {
+				return nil, err
+			}
+			*field.dst = ids
+		}
+	}
+
+	if err := s.userRepo.UpdatePrivacySettings(ctx, oid, settings); err != nil {
+		return nil, domain.NewInternal("failed to update privacy settings: " + err.Error())
+	}
+
+	resp := privacySettingsResponse(settings, settings.StatsVisibility)
+	return &resp, nil
+}
+
+// parseOwnCircleIDs 는 공개 범위에 지정한 서클이 모두 내 서클인지 확인한다.
+func parseOwnCircleIDs(ids []string, circles []model.Circle) ([]primitive.ObjectID, error) {
+	result := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		oid, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, domain.NewBadRequest(domain.ErrCircleNotFound, "invalid circle id: "+id)
+		}
+		found := false
+		for _, c := range circles {
+			if c.ID == oid {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, domain.NewNotFound(domain.ErrCircleNotFound, "circle not found: "+id)
+		}
+		if !containsObjectID(result, oid) {
+			result = append(result, oid)
+		}
+	}
+	return result, nil
+}
+
+func privacySettingsResponse(settings model.PrivacySettings, visibility model.StatsVisibility) dto.PrivacySettings {
+	hexIDs := func(ids []primitive.ObjectID) []string {
+		result := make([]string, len(ids))
+		for i, id := range ids {
+			result[i] = id.Hex()
+		}
+		return result
+	}
+
+	return dto.PrivacySettings{
+		StatsVisibility: string(visibility),
+		StatsCircles:    hexIDs(settings.StatsCircles),
+		PresenceCircles: hexIDs(settings.PresenceCircles),
+		SessionCircles:  hexIDs(settings.SessionCircles),
+		ShareActivities: settings.ShareActivities,
+	}
+}
+
+func (s *userService) GetProfile(ctx context.Context, userID string, targetID string) (*dto.UserProfileResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	targetOid, err := primitive.ObjectIDFromHex(targetID)
+	if err != nil {
+		return nil, domain.NewNotFound(domain.ErrUserNotFound, "invalid target user id")
+	}
+
+	target, err := s.userRepo.FindByID(ctx, targetOid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find user: " + err.Error())
+	}
+	if target == nil {
+		return nil, domain.NewNotFound(domain.ErrUserNotFound, "user not found")
+	}
+
+	status, err := s.friendshipStatus(ctx, oid, targetOid)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.UserProfileResponse{
+		UserID:           target.ID.Hex(),
+		Nickname:         target.Nickname,
+		Tag:              target.Tag,
+		Avatar:           avatarURLs(s.blobStorage, target.AvatarKey),
+		FriendshipStatus: status,
+	}
+
+	canView := canViewStats(target, status)
+	// 친구 공개여도 서클을 지정했다면 그 서클에 속한 친구만 볼 수 있음
+	if canView && status == friendshipStatusFriend {
+		viewer, err := loadCircleViewer(ctx, s.circleRepo, oid, []model.User{*target})
+		if err != nil {
+			return nil, domain.NewInternal("failed to find circles: " + err.Error())
+		}
+		canView = viewer.canSeeStats(target)
+	}
+
+	if canView {
+		stats, err := s.profileStats(ctx, targetOid)
+		if err != nil {
+			return nil, domain.NewInternal("failed to aggregate void stats: " + err.Error())
+		}
+		resp.Stats = stats
+	}
+
+	return resp, nil
+}
+
+// friendshipStatus 는 나와 대상 유저의 관계를 반환한다.
+// 나를 차단한 유저는 검색과 마찬가지로 존재하지 않는 유저로 취급한다.
+func (s *userService) friendshipStatus(ctx context.Context, userID, targetID primitive.ObjectID) (string, error) {
+	if userID == targetID {
+		return friendshipStatusSelf, nil
+	}
+
+	blockedMe, err := s.blockRepo.FindOne(ctx, targetID, userID)
+	if err != nil {
+		return "", domain.NewInternal("failed to check block: " + err.Error())
+	}
+	if blockedMe != nil {
+		return "", domain.NewNotFound(domain.ErrUserNotFound, "user not found")
+	}
+
+	myBlock, err := s.blockRepo.FindOne(ctx, userID, targetID)
+	if err != nil {
+		return "", domain.NewInternal("failed to check block: " + err.Error())
+	}
+	if myBlock != nil {
+		return friendshipStatusBlocked, nil
+	}
+
+	friendship, err := s.friendshipRepo.FindOne(ctx, userID, targetID)
+	if err != nil {
+		return "", domain.NewInternal("failed to check friendship: " + err.Error())
+	}
+	if friendship != nil {
+		return friendshipStatusFriend, nil
+	}
+
+	sent, err := s.friendRequestRepo.FindPending(ctx, userID, targetID)
+	if err != nil {
+		return "", domain.NewInternal("failed to check friend request: " + err.Error())
+	}
+	if sent != nil {
+		return friendshipStatusPendingSent, nil
+	}
+
+	// 거절된 요청은 만료될 때까지 보낸 사람에게 대기 중으로 보임
+	rejected, err := s.friendRequestRepo.FindLatestRejected(ctx, userID, targetID)
+	if err != nil {
+		return "", domain.NewInternal("failed to check friend request: " + err.Error())
+	}
+	if rejected != nil && rejected.Status == model.FriendRequestRejected && !rejected.SenderHidden {
+		return friendshipStatusPendingSent, nil
+	}
+
+	received, err := s.friendRequestRepo.FindPending(ctx, targetID, userID)
+	if err != nil {
+		return "", domain.NewInternal("failed to check friend request: " + err.Error())
+	}
+	if received != nil {
+		return friendshipStatusPendingReceived, nil
+	}
+
+	return friendshipStatusNone, nil
+}
+
+func canViewStats(target *model.User, status string) bool {
+	switch status {
+	case friendshipStatusSelf:
+		return true
+	case friendshipStatusBlocked:
+		return false
+	}
+
+	switch statsVisibility(target) {
+	case model.StatsVisibilityEveryone:
+		return true
+	case model.StatsVisibilityFriends:
+		return status == friendshipStatusFriend
+	default:
+		return false
+	}
+}
+
+// 설정이 없는 기존 유저는 친구 공개로 취급
+func statsVisibility(user *model.User) model.StatsVisibility {
+	if user.PrivacySettings.StatsVisibility == "" {
+		return model.StatsVisibilityFriends
+	}
+	return user.PrivacySettings.StatsVisibility
+}
+
+// profileStats 는 연속 공백 일수와 이번 주 총 공백 시간을 계산한다.
+func (s *userService) profileStats(ctx context.Context, userID primitive.ObjectID) (*dto.ProfileStats, error) {
+	today, err := time.ParseInLocation("2006-01-02", config.CalcTargetDay(time.Now()), config.KST)
+	if err != nil {
+		return nil, err
+	}
+	fromDay := today.AddDate(0, 0, -streakLookbackDays).Format("2006-01-02")
+
+	durations, err := s.voidSessionRepo.AggregateDailyDurations(ctx, userID, fromDay)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make(map[string]int64, len(durations))
+	for _, d := range durations {
+		days[d.TargetDay] = d.TotalDurationSec
+	}
+
+	// 오늘 아직 공백하지 않았으면 어제까지의 연속 기록을 유지
+	day := today
+	if _, ok := days[day.Format("2006-01-02")]; !ok {
+		day = day.AddDate(0, 0, -1)
+	}
+	streak := consecutiveDays(days, day)
+
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7)).Format("2006-01-02")
+	var weekTotal int64
+	for _, d := range durations {
+		if d.TargetDay >= weekStart {
+			weekTotal += d.TotalDurationSec
+		}
+	}
+
+	return &dto.ProfileStats{
+		StreakDays:           streak,
+		WeekTotalDurationSec: weekTotal,
+	}, nil
+}
+
+// consecutiveDays 는 day 부터 하루씩 거슬러 올라가며 공백한 날이 이어진 일수를 센다.
+func consecutiveDays(days map[string]int64, day time.Time) int {
+	streak := 0
+	for {
+		if _, ok := days[day.Format("2006-01-02")]; !ok {
+			return streak
+		}
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+}
+
 func (s *userService) Search(ctx context.Context, userID string, tagPrefix string) (*dto.UserSearchResponse, error) {
 	oid, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
@@ -150,12 +500,28 @@ func (s *userService) Search(ctx context.Context, userID string, tagPrefix strin
 		return nil, domain.NewInternal("failed to search users: " + err.Error())
 	}
 
+	// 태그를 변경한 유저의 예전 태그로 정확히 검색하면 예약 기간 동안 해당 유저도 포함
+	reservation, err := s.tagReservationRepo.FindActiveByTag(ctx, strings.ToUpper(tagPrefix))
+	if err != nil {
+		return nil, domain.NewInternal("failed to find tag reservation: " + err.Error())
+	}
+	if reservation != nil && !containsObjectID(excludeIDs, reservation.UserID) && !containsUser(users, reservation.UserID) {
+		owner, err := s.userRepo.FindByID(ctx, reservation.UserID)
+		if err != nil {
+			return nil, domain.NewInternal("failed to find user: " + err.Error())
+		}
+		if owner != nil {
+			users = append([]model.User{*owner}, users...)
+		}
+	}
+
 	items := make([]dto.UserSearchItem, len(users))
 	for i, u := range users {
 		items[i] = dto.UserSearchItem{
 			UserID:    u.ID.Hex(),
 			Nickname:  u.Nickname,
 			Tag:       u.Tag,
+			Avatar:    avatarURLs(s.blobStorage, u.AvatarKey),
 			IsBlocked: myBlockedSet[u.ID],
 		}
 	}
@@ -237,6 +603,10 @@ func (s *userService) Block(ctx context.Context, userID string, targetID string)
 		return domain.NewInternal("failed to delete friend requests: " + err.Error())
 	}
 
+	if err := removeFromCircles(ctx, s.circleRepo, oid, targetOid); err != nil {
+		return domain.NewInternal("failed to remove from circles: " + err.Error())
+	}
+
 	return nil
 }
 
@@ -267,9 +637,8 @@ func (s *userService) Unblock(ctx context.Context, userID string, targetID strin
 }
 
 func (s *userService) ChangeNickname(ctx context.Context, userID string, req dto.ChangeNicknameRequest) (*dto.ChangeNicknameResponse, error) {
-	length := utf8.RuneCountInString(req.Nickname)
-	if length < 3 || length > 15 {
-		return nil, domain.NewBadRequest(domain.ErrInvalidNickname, "nickname must be 3-15 characters")
+	if err := validateNickname(s.nicknameFilter, req.Nickname); err != nil {
+		return nil, err
 	}
 
 	oid, err := primitive.ObjectIDFromHex(userID)
@@ -286,9 +655,218 @@ func (s *userService) ChangeNickname(ctx context.Context, userID string, req dto
 		return nil, domain.NewBadRequest(domain.ErrInvalidNickname, "nickname is not set yet")
 	}
 
-	if err := s.userRepo.UpdateNickname(ctx, oid, req.Nickname); err != nil {
-		return nil, domain.NewInternal("failed to update nickname: " + err.Error())
+	if user.Nickname == req.Nickname {
+		return &dto.ChangeNicknameResponse{Nickname: req.Nickname}, nil
+	}
+
+	nextChangeAt, err := s.nextNicknameChangeAt(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find nickname history: " + err.Error())
+	}
+	if nextChangeAt != nil {
+		return nil, domain.NewForbidden(domain.ErrNicknameChangeCooldown,
+			"nickname can be changed after "+nextChangeAt.Format(time.RFC3339)).
+			WithData(dto.NicknameCooldownData{NextChangeAt: *nextChangeAt})
+	}
+
+	// 이력을 먼저 남겨 동시에 들어온 다른 요청이 대기 시간에 걸리게 하고,
+	// 닉네임은 읽어 온 값 그대로일 때만 바꿔 먼저 바뀐 경우 이 요청을 거절한다.
+	history := &model.NicknameHistory{
+		UserID:      oid,
+		OldNickname: user.Nickname,
+		NewNickname: req.Nickname,
+		ChangedAt:   time.Now(),
+	}
+	if err := s.nicknameHistoryRepo.Create(ctx, history); err != nil {
+		return nil, domain.NewInternal("failed to create nickname history: " + err.Error())
+	}
+
+	replaced, err := s.userRepo.ReplaceNickname(ctx, oid, user.Nickname, req.Nickname)
+	if err != nil || !replaced {
+		if delErr := s.nicknameHistoryRepo.Delete(ctx, history.ID); delErr != nil {
+			log.Printf("[USER] failed to delete nickname history %s: %v\n", history.ID.Hex(), delErr)
+		}
+		if err != nil {
+			return nil, domain.NewInternal("failed to update nickname: " + err.Error())
+		}
+		return nil, domain.NewConflict(domain.ErrConflict, "nickname was changed by another request")
 	}
 
 	return &dto.ChangeNicknameResponse{Nickname: req.Nickname}, nil
 }
+
+// nextNicknameChangeAt 은 닉네임 변경 대기 중이면 다음 변경 가능 시각을, 아니면 nil 을 반환한다.
+func (s *userService) nextNicknameChangeAt(ctx context.Context, userID primitive.ObjectID) (*time.Time, error) {
+	latest, err := s.nicknameHistoryRepo.FindLatestByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	next := latest.ChangedAt.Add(s.nicknameCooldown)
+	if !time.Now().Before(next) {
+		return nil, nil
+	}
+	return &next, nil
+}
+
+func (s *userService) ChangeTag(ctx context.Context, userID string, req dto.ChangeTagRequest) (*dto.ChangeTagResponse, error) {
+	// 대소문자 구분 없이 유일해야 하므로 대문자로 저장해 tag unique index 를 그대로 사용
+	tag := strings.ToUpper(req.Tag)
+	if !tagPattern.MatchString(tag) {
+		return nil, domain.NewBadRequest(domain.ErrInvalidTag, "tag must be 4-12 letters or digits")
+	}
+	if !s.nicknameFilter.IsAllowed(tag) {
+		return nil, domain.NewBadRequest(domain.ErrInvalidTag, "tag contains a banned word or reserved name")
+	}
+
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil || user == nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+
+	if user.Tag == tag {
+		return &dto.ChangeTagResponse{Tag: tag}, nil
+	}
+
+	now := time.Now()
+	if user.TagChangedAt != nil {
+		if s.tagCooldown <= 0 {
+			return nil, domain.NewForbidden(domain.ErrTagChangeCooldown, "tag can only be changed once").
+				WithData(dto.TagCooldownData{NextChangeAt: nil})
+		}
+		next := user.TagChangedAt.Add(s.tagCooldown)
+		if now.Before(next) {
+			return nil, domain.NewForbidden(domain.ErrTagChangeCooldown,
+				"tag can be changed after "+next.Format(time.RFC3339)).
+				WithData(dto.TagCooldownData{NextChangeAt: &next})
+		}
+	}
+
+	// 다른 유저의 예전 태그는 예약 기간 동안 사용할 수 없음 (본인의 예전 태그는 되찾을 수 있음)
+	reservation, err := s.tagReservationRepo.FindActiveByTag(ctx, tag)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find tag reservation: " + err.Error())
+	}
+	if reservation != nil && reservation.UserID != oid {
+		return nil, domain.NewConflict(domain.ErrTagAlreadyTaken, "tag is reserved")
+	}
+
+	// 예전 태그가 풀리는 순간 다른 유저가 가져가지 않도록 예약을 먼저 남기고 태그를 바꿈
+	if err := s.tagReservationRepo.Upsert(ctx, &model.TagReservation{
+		Tag:       user.Tag,
+		UserID:    oid,
+		ExpiresAt: now.Add(s.tagReservationPeriod),
+		CreatedAt: now,
+	}); err != nil {
+		return nil, domain.NewInternal("failed to reserve previous tag: " + err.Error())
+	}
+
+	if err := s.userRepo.UpdateTag(ctx, oid, tag, now); err != nil {
+		if delErr := s.tagReservationRepo.DeleteByTag(ctx, user.Tag); delErr != nil {
+			log.Printf("[USER] failed to delete tag reservation %s: %v\n", user.Tag, delErr)
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, domain.NewConflict(domain.ErrTagAlreadyTaken, "tag already taken")
+		}
+		return nil, domain.NewInternal("failed to update tag: " + err.Error())
+	}
+
+	if reservation != nil {
+		if err := s.tagReservationRepo.DeleteByTag(ctx, tag); err != nil {
+			return nil, domain.NewInternal("failed to delete tag reservation: " + err.Error())
+		}
+	}
+
+	return &dto.ChangeTagResponse{Tag: tag}, nil
+}
+
+func (s *userService) UploadAvatar(ctx context.Context, userID string, data []byte) (*dto.AvatarResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil || user == nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+
+	thumbnails, err := imaging.SquareThumbnails(data, avatarSizes)
+	if err != nil {
+		if errors.Is(err, imaging.ErrTooLarge) {
+			return nil, domain.NewPayloadTooLarge(domain.ErrImageTooLarge, "image dimensions too large")
+		}
+		if errors.Is(err, imaging.ErrUnsupportedFormat) {
+			return nil, domain.NewBadRequest(domain.ErrInvalidImage, "image must be jpeg or png")
+		}
+		return nil, domain.NewInternal("failed to process image: " + err.Error())
+	}
+
+	// 캐시 문제를 피하기 위해 업로드마다 새 키 사용
+	avatarKey := fmt.Sprintf("avatars/%s/%d", oid.Hex(), time.Now().UnixNano())
+	for _, size := range avatarSizes {
+		if err := s.blobStorage.Put(ctx, avatarObjectKey(avatarKey, size), "image/jpeg", thumbnails[size]); err != nil {
+			return nil, domain.NewInternal("failed to store avatar: " + err.Error())
+		}
+	}
+
+	if err := s.userRepo.UpdateAvatar(ctx, oid, avatarKey); err != nil {
+		deleteAvatarObjects(ctx, s.blobStorage, avatarKey)
+		return nil, domain.NewInternal("failed to update avatar: " + err.Error())
+	}
+
+	if user.AvatarKey != "" {
+		deleteAvatarObjects(ctx, s.blobStorage, user.AvatarKey)
+	}
+
+	return &dto.AvatarResponse{Avatar: *avatarURLs(s.blobStorage, avatarKey)}, nil
+}
+
+func (s *userService) DeleteAvatar(ctx context.Context, userID string) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil || user == nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+
+	if user.AvatarKey == "" {
+		return nil
+	}
+
+	if err := s.userRepo.UpdateAvatar(ctx, oid, ""); err != nil {
+		return domain.NewInternal("failed to delete avatar: " + err.Error())
+	}
+
+	deleteAvatarObjects(ctx, s.blobStorage, user.AvatarKey)
+	return nil
+}
+
+func containsObjectID(ids []primitive.ObjectID, id primitive.ObjectID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsUser(users []model.User, id primitive.ObjectID) bool {
+	for _, u := range users {
+		if u.ID == id {
+			return true
+		}
+	}
+	return false
+}
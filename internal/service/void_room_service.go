@@ -0,0 +1,566 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"dangbamgong-backend/internal/config"
+	"dangbamgong-backend/internal/domain"
+	"dangbamgong-backend/internal/dto"
+	"dangbamgong-backend/internal/model"
+	"dangbamgong-backend/internal/repository"
+	"dangbamgong-backend/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VoidRoomService 는 친구들과 함께 공백하는 방을 다룬다.
+// 방에 들어가 있는 동안 끝낸 공백은 voidService.End 에서 방에 연결되고,
+// 방 멤버끼리는 공개 범위 설정과 관계없이 서로의 공백 여부와 시간을 볼 수 있다.
+// VOID_ROOM_IDLE_TIMEOUT(기본 6시간) 동안 공백 중인 멤버도 활동도 없는 방은 Watch 가 닫는다.
+type VoidRoomService interface {
+	Create(ctx context.Context, userID string, req dto.CreateVoidRoomRequest) (*dto.VoidRoomResponse, error)
+	GetCurrent(ctx context.Context, userID string) (*dto.VoidRoomResponse, error)
+	Get(ctx context.Context, userID string, roomID string) (*dto.VoidRoomResponse, error)
+	Invite(ctx context.Context, userID string, roomID string, req dto.InviteVoidRoomRequest) error
+	Join(ctx context.Context, userID string, roomID string) (*dto.VoidRoomResponse, error)
+	Leave(ctx context.Context, userID string, roomID string) (*dto.VoidRoomResponse, error)
+	Watch(ctx context.Context)
+}
+
+type voidRoomService struct {
+	roomRepo        repository.VoidRoomRepository
+	userRepo        repository.UserRepository
+	friendshipRepo  repository.FriendshipRepository
+	blockRepo       repository.BlockRepository
+	voidSessionRepo repository.VoidSessionRepository
+	notifSvc        NotificationService
+	blobStorage     storage.BlobStorage
+	maxMembers      int
+	idleTimeout     time.Duration
+}
+
+const voidRoomIdleBatchSize = 100
+
+func NewVoidRoomService(
+	vrr repository.VoidRoomRepository,
+	ur repository.UserRepository,
+	fr repository.FriendshipRepository,
+	br repository.BlockRepository,
+	vr repository.VoidSessionRepository,
+	ns NotificationService,
+	bs storage.BlobStorage,
+) VoidRoomService {
+	return &voidRoomService{
+		roomRepo:        vrr,
+		userRepo:        ur,
+		friendshipRepo:  fr,
+		blockRepo:       br,
+		voidSessionRepo: vr,
+		notifSvc:        ns,
+		blobStorage:     bs,
+		maxMembers:      config.GetInt("VOID_ROOM_MAX_MEMBERS", 8),
+		idleTimeout:     config.GetDuration("VOID_ROOM_IDLE_TIMEOUT", 6*time.Hour),
+	}
+}
+
+func (s *voidRoomService) Create(ctx context.Context, userID string, req dto.CreateVoidRoomRequest) (*dto.VoidRoomResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil || user == nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+
+	current, err := s.roomRepo.FindOpenByMemberID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find void room: " + err.Error())
+	}
+	if current != nil {
+		return nil, domain.NewConflict(domain.ErrAlreadyInRoom, "already in a void room")
+	}
+
+	invitees, err := s.parseInvitees(ctx, oid, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	room := &model.VoidRoom{
+		OwnerID:        oid,
+		Status:         model.VoidRoomOpen,
+		MemberIDs:      []primitive.ObjectID{oid},
+		ParticipantIDs: []primitive.ObjectID{oid},
+		InvitedIDs:     invitees,
+		ActiveAt:       now,
+		CreatedAt:      now,
+	}
+	if err := s.roomRepo.Create(ctx, room); err != nil {
+		return nil, domain.NewInternal("failed to create void room: " + err.Error())
+	}
+
+	for _, id := range invitees {
+		_ = s.notifSvc.SendVoidRoomInvite(ctx, id, user.Nickname, room.ID)
+	}
+
+	return s.roomResponse(ctx, oid, room)
+}
+
+func (s *voidRoomService) GetCurrent(ctx context.Context, userID string) (*dto.VoidRoomResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	room, err := s.roomRepo.FindOpenByMemberID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find void room: " + err.Error())
+	}
+	if room == nil {
+		return nil, domain.NewNotFound(domain.ErrRoomNotFound, "not in a void room")
+	}
+
+	return s.roomResponse(ctx, oid, room)
+}
+
+// Get 은 들어갔던 방이나 초대받은 열린 방을 반환한다.
+func (s *voidRoomService) Get(ctx context.Context, userID string, roomID string) (*dto.VoidRoomResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	room, err := s.findRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	invited := room.Status == model.VoidRoomOpen && containsObjectID(room.InvitedIDs, oid)
+	if !invited && !containsObjectID(room.ParticipantIDs, oid) {
+		return nil, domain.NewNotFound(domain.ErrRoomNotFound, "void room not found: "+roomID)
+	}
+
+	return s.roomResponse(ctx, oid, room)
+}
+
+// Invite 는 방 멤버가 자기 친구를 초대한다. 이미 초대받은 유저는 알림을 다시 보내지 않는다.
+func (s *voidRoomService) Invite(ctx context.Context, userID string, roomID string, req dto.InviteVoidRoomRequest) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil || user == nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+
+	room, err := s.findOpenMemberRoom(ctx, oid, roomID)
+	if err != nil {
+		return err
+	}
+
+	invitees, err := s.parseInvitees(ctx, oid, req.UserIDs)
+	if err != nil {
+		return err
+	}
+
+	var newInvitees []primitive.ObjectID
+	for _, id := range invitees {
+		if !containsObjectID(room.InvitedIDs, id) && !containsObjectID(room.MemberIDs, id) {
+			newInvitees = append(newInvitees, id)
+		}
+	}
+	if len(newInvitees) == 0 {
+		return nil
+	}
+
+	if err := s.roomRepo.Invite(ctx, room.ID, newInvitees); err != nil {
+		return domain.NewInternal("failed to invite to void room: " + err.Error())
+	}
+
+	for _, id := range newInvitees {
+		_ = s.notifSvc.SendVoidRoomInvite(ctx, id, user.Nickname, room.ID)
+	}
+	return nil
+}
+
+// Join 은 초대받은 방에 들어간다. 차단 관계인 멤버가 있는 방은 찾지 못한 것으로 본다.
+func (s *voidRoomService) Join(ctx context.Context, userID string, roomID string) (*dto.VoidRoomResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	user, err := s.userRepo.FindByID(ctx, oid)
+	if err != nil || user == nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "user not found")
+	}
+
+	room, err := s.findRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.Status != model.VoidRoomOpen || !containsObjectID(room.InvitedIDs, oid) {
+		return nil, domain.NewNotFound(domain.ErrRoomNotFound, "void room not found: "+roomID)
+	}
+
+	current, err := s.roomRepo.FindOpenByMemberID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find void room: " + err.Error())
+	}
+	if current != nil {
+		if current.ID == room.ID {
+			return s.roomResponse(ctx, oid, current)
+		}
+		return nil, domain.NewConflict(domain.ErrAlreadyInRoom, "already in a void room")
+	}
+
+	blocked, err := s.blockedUserIDs(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range room.MemberIDs {
+		if blocked[id] {
+			return nil, domain.NewNotFound(domain.ErrRoomNotFound, "void room not found: "+roomID)
+		}
+	}
+
+	ok, err := s.roomRepo.Join(ctx, room.ID, oid, s.maxMembers)
+	if err != nil {
+		return nil, domain.NewInternal("failed to join void room: " + err.Error())
+	}
+	if !ok {
+		// 그사이 방이 닫혔거나 정원이 찼음
+		room, err = s.roomRepo.FindByID(ctx, room.ID)
+		if err != nil {
+			return nil, domain.NewInternal("failed to find void room: " + err.Error())
+		}
+		if room == nil || room.Status != model.VoidRoomOpen {
+			return nil, domain.NewNotFound(domain.ErrRoomNotFound, "void room not found: "+roomID)
+		}
+		return nil, domain.NewConflict(domain.ErrRoomFull, "void room is full")
+	}
+
+	room, err = s.roomRepo.FindByID(ctx, room.ID)
+	if err != nil || room == nil {
+		return nil, domain.NewInternal("failed to find void room after join")
+	}
+
+	for _, id := range room.MemberIDs {
+		if id != oid {
+			_ = s.notifSvc.SendVoidRoomJoin(ctx, id, user.Nickname, room.ID)
+		}
+	}
+
+	return s.roomResponse(ctx, oid, room)
+}
+
+// Leave 는 방에서 나간다. 마지막 멤버가 나가면 방을 닫고 합계를 기록한다.
+// 공백 중에 나가면 그 공백은 방에 연결되지 않는다.
+func (s *voidRoomService) Leave(ctx context.Context, userID string, roomID string) (*dto.VoidRoomResponse, error) {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	roomOid, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return nil, domain.NewBadRequest(domain.ErrRoomNotFound, "invalid room id")
+	}
+
+	room, err := leaveVoidRoom(ctx, s.roomRepo, s.voidSessionRepo, roomOid, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to leave void room: " + err.Error())
+	}
+	if room == nil {
+		return nil, domain.NewNotFound(domain.ErrRoomNotFound, "void room not found: "+roomID)
+	}
+
+	return s.roomResponse(ctx, oid, room)
+}
+
+// leaveVoidRoom 은 유저를 방에서 빼고, 마지막 멤버였으면 방을 닫는다. 멤버가 아니었으면 nil.
+// 탈퇴 요청에서도 같은 경로로 방을 나간다.
+func leaveVoidRoom(ctx context.Context, roomRepo repository.VoidRoomRepository, voidSessionRepo repository.VoidSessionRepository, roomID, userID primitive.ObjectID) (*model.VoidRoom, error) {
+	room, err := roomRepo.Leave(ctx, roomID, userID)
+	if err != nil || room == nil {
+		return nil, err
+	}
+
+	if len(room.MemberIDs) == 0 {
+		if err := closeVoidRoom(ctx, roomRepo, voidSessionRepo, room); err != nil {
+			// 닫지 못한 빈 방은 아무도 찾을 수 없으므로 나가기는 그대로 처리
+			log.Printf("[ROOM] failed to close void room %s: %v\n", room.ID.Hex(), err)
+		}
+	}
+	return room, nil
+}
+
+// closeVoidRoom 은 방에 연결된 공백을 합산해 빈 방을 닫는다. 성공하면 room 도 닫힌 상태로 바꾼다.
+func closeVoidRoom(ctx context.Context, roomRepo repository.VoidRoomRepository, voidSessionRepo repository.VoidSessionRepository, room *model.VoidRoom) error {
+	total, count, err := roomTotals(ctx, voidSessionRepo, room.ID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ok, err := roomRepo.Close(ctx, room.ID, total, count, now)
+	if err != nil || !ok {
+		return err
+	}
+
+	room.Status = model.VoidRoomClosed
+	room.TotalDurationSec = total
+	room.SessionCount = count
+	room.ClosedAt = &now
+	log.Printf("[ROOM] closed void room %s, total %ds over %d sessions\n", room.ID.Hex(), total, count)
+	return nil
+}
+
+func roomTotals(ctx context.Context, voidSessionRepo repository.VoidSessionRepository, roomID primitive.ObjectID) (int64, int, error) {
+	durations, err := voidSessionRepo.AggregateRoomDurations(ctx, roomID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var total int64
+	var count int
+	for _, d := range durations {
+		total += d.TotalDurationSec
+		count += d.SessionCount
+	}
+	return total, count, nil
+}
+
+// CloseIdle 은 VOID_ROOM_IDLE_TIMEOUT 동안 활동이 없는 방을 남은 멤버와 관계없이 닫고 닫은 수를 반환한다.
+// 멤버가 공백 중인 방은 그 공백이 끝날 때까지 열어 둔다.
+func (s *voidRoomService) CloseIdle(ctx context.Context) int {
+	activeBefore := time.Now().Add(-s.idleTimeout)
+	rooms, err := s.roomRepo.FindIdle(ctx, activeBefore, voidRoomIdleBatchSize)
+	if err != nil {
+		log.Printf("[ROOM] failed to find idle void rooms: %v\n", err)
+		return 0
+	}
+
+	closed := 0
+	for _, room := range rooms {
+		members, err := s.userRepo.FindByIDs(ctx, room.MemberIDs)
+		if err != nil {
+			log.Printf("[ROOM] failed to find members of void room %s: %v\n", room.ID.Hex(), err)
+			continue
+		}
+		inVoid := false
+		for _, u := range members {
+			inVoid = inVoid || u.IsInVoid
+		}
+		if inVoid {
+			continue
+		}
+
+		total, count, err := roomTotals(ctx, s.voidSessionRepo, room.ID)
+		if err != nil {
+			log.Printf("[ROOM] failed to aggregate void room %s: %v\n", room.ID.Hex(), err)
+			continue
+		}
+		ok, err := s.roomRepo.CloseIdle(ctx, room.ID, activeBefore, total, count, time.Now())
+		if err != nil {
+			log.Printf("[ROOM] failed to close idle void room %s: %v\n", room.ID.Hex(), err)
+			continue
+		}
+		if ok {
+			closed++
+		}
+	}
+	return closed
+}
+
+// Watch 는 VOID_ROOM_IDLE_INTERVAL(기본 10분)마다 쉬는 방을 닫는다.
+func (s *voidRoomService) Watch(ctx context.Context) {
+	interval := config.GetDuration("VOID_ROOM_IDLE_INTERVAL", 10*time.Minute)
+	if interval <= 0 || s.idleTimeout <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if n := s.CloseIdle(ctx); n > 0 {
+				log.Printf("[ROOM] closed %d idle void rooms\n", n)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// parseInvitees 는 초대할 유저가 모두 userID 의 친구인지 확인한다.
+func (s *voidRoomService) parseInvitees(ctx context.Context, userID primitive.ObjectID, ids []string) ([]primitive.ObjectID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	friendships, err := s.friendshipRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find friendships: " + err.Error())
+	}
+	friendIDs := make(map[primitive.ObjectID]bool, len(friendships))
+	for _, f := range friendships {
+		friendIDs[f.FriendID] = true
+	}
+
+	invitees := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		inviteeID, err := primitive.ObjectIDFromHex(id)
+		if err != nil || !friendIDs[inviteeID] {
+			return nil, domain.NewBadRequest(domain.ErrRoomInviteeNotFriend, "not a friend: "+id)
+		}
+		if !containsObjectID(invitees, inviteeID) {
+			invitees = append(invitees, inviteeID)
+		}
+	}
+	return invitees, nil
+}
+
+func (s *voidRoomService) findRoom(ctx context.Context, roomID string) (*model.VoidRoom, error) {
+	roomOid, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return nil, domain.NewBadRequest(domain.ErrRoomNotFound, "invalid room id")
+	}
+
+	room, err := s.roomRepo.FindByID(ctx, roomOid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find void room: " + err.Error())
+	}
+	if room == nil {
+		return nil, domain.NewNotFound(domain.ErrRoomNotFound, "void room not found: "+roomID)
+	}
+	return room, nil
+}
+
+// findOpenMemberRoom 은 userID 가 멤버로 있는 열린 방을 찾는다. 아니면 존재하지 않는 방으로 취급한다.
+func (s *voidRoomService) findOpenMemberRoom(ctx context.Context, userID primitive.ObjectID, roomID string) (*model.VoidRoom, error) {
+	room, err := s.findRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.Status != model.VoidRoomOpen || !containsObjectID(room.MemberIDs, userID) {
+		return nil, domain.NewNotFound(domain.ErrRoomNotFound, "void room not found: "+roomID)
+	}
+	return room, nil
+}
+
+// blockedUserIDs 는 userID 와 어느 쪽이든 차단 관계인 유저를 반환한다.
+func (s *voidRoomService) blockedUserIDs(ctx context.Context, userID primitive.ObjectID) (map[primitive.ObjectID]bool, error) {
+	blocks, err := s.blockRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find blocks: " + err.Error())
+	}
+	blockedBy, err := s.blockRepo.FindByBlockedID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find blocks: " + err.Error())
+	}
+
+	blocked := make(map[primitive.ObjectID]bool, len(blocks)+len(blockedBy))
+	for _, b := range blocks {
+		blocked[b.BlockedID] = true
+	}
+	for _, b := range blockedBy {
+		blocked[b.UserID] = true
+	}
+	return blocked, nil
+}
+
+// roomResponse 는 방에 들어왔던 유저를 지금 멤버부터 보여준다. 차단 관계인 유저와 탈퇴 유예 중인 유저는 뺀다.
+// 공백 여부와 시간은 보는 사람도 방 멤버일 때만 보여준다. 초대만 받은 유저에게는 보여주지 않는다.
+func (s *voidRoomService) roomResponse(ctx context.Context, viewerID primitive.ObjectID, room *model.VoidRoom) (*dto.VoidRoomResponse, error) {
+	durations, err := s.voidSessionRepo.AggregateRoomDurations(ctx, room.ID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to aggregate void room: " + err.Error())
+	}
+	durationMap := make(map[primitive.ObjectID]model.VoidRoomDuration, len(durations))
+	for _, d := range durations {
+		durationMap[d.UserID] = d
+	}
+
+	blocked, err := s.blockedUserIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, room.ParticipantIDs)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find users: " + err.Error())
+	}
+	userMap := make(map[primitive.ObjectID]*model.User, len(users))
+	for i := range users {
+		userMap[users[i].ID] = &users[i]
+	}
+
+	resp := &dto.VoidRoomResponse{
+		RoomID:     room.ID.Hex(),
+		OwnerID:    room.OwnerID.Hex(),
+		Status:     string(room.Status),
+		Members:    []dto.VoidRoomMember{},
+		MaxMembers: s.maxMembers,
+		CreatedAt:  room.CreatedAt,
+		ClosedAt:   room.ClosedAt,
+	}
+
+	now := time.Now()
+	viewerIsMember := room.Status == model.VoidRoomOpen && containsObjectID(room.MemberIDs, viewerID)
+	var current, left []dto.VoidRoomMember
+	for _, id := range room.ParticipantIDs {
+		d := durationMap[id]
+		resp.TotalDurationSec += d.TotalDurationSec
+		resp.SessionCount += d.SessionCount
+
+		u, ok := userMap[id]
+		if !ok || blocked[id] {
+			continue
+		}
+
+		member := dto.VoidRoomMember{
+			User: dto.UserSearchItem{
+				UserID:   u.ID.Hex(),
+				Nickname: u.Nickname,
+				Tag:      u.Tag,
+				Avatar:   avatarURLs(s.blobStorage, u.AvatarKey),
+			},
+			IsMember:         room.Status == model.VoidRoomOpen && containsObjectID(room.MemberIDs, id),
+			TotalDurationSec: d.TotalDurationSec,
+			SessionCount:     d.SessionCount,
+		}
+		if viewerIsMember && member.IsMember && u.IsInVoid && u.CurrentVoidStartedAt != nil {
+			elapsed := int64(now.Sub(*u.CurrentVoidStartedAt).Seconds())
+			member.IsInVoid = true
+			member.VoidStartedAt = u.CurrentVoidStartedAt
+			member.ElapsedSec = &elapsed
+		}
+
+		if member.IsMember {
+			current = append(current, member)
+		} else {
+			left = append(left, member)
+		}
+	}
+	resp.Members = append(append(resp.Members, current...), left...)
+
+	// 닫힌 방은 닫힐 때 기록한 합계를 그대로 보여줌
+	if room.Status == model.VoidRoomClosed {
+		resp.TotalDurationSec = room.TotalDurationSec
+		resp.SessionCount = room.SessionCount
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"log"
+	"slices"
 	"time"
 
 	"dangbamgong-backend/internal/config"
@@ -19,12 +21,29 @@ type VoidService interface {
 	Cancel(ctx context.Context, userID string) error
 	History(ctx context.Context, userID string, targetDay string) (*dto.VoidHistoryResponse, error)
 	TestCreate(ctx context.Context, userID string, req dto.TestVoidRequest) (*dto.VoidEndResponse, error)
+	React(ctx context.Context, userID string, sessionID string, req dto.SessionReactionRequest) error
+	RemoveReaction(ctx context.Context, userID string, sessionID string) error
+}
+
+// 공백 기록에 남길 수 있는 반응
+var sessionReactions = []model.ReactionEmoji{
+	model.ReactionClap,
+	model.ReactionFire,
+	model.ReactionHeart,
+	model.ReactionMoon,
+	model.ReactionCoffee,
 }
 
 type voidService struct {
 	userRepo          repository.UserRepository
 	voidSessionRepo   repository.VoidSessionRepository
 	activityRepo      repository.ActivityRepository
+	friendshipRepo    repository.FriendshipRepository
+	blockRepo         repository.BlockRepository
+	circleRepo        repository.CircleRepository
+	reactionRepo      repository.SessionReactionRepository
+	roomRepo          repository.VoidRoomRepository
+	feedSvc           FeedService
 	reminderScheduler *VoidReminderScheduler
 }
 
@@ -32,12 +51,24 @@ func NewVoidService(
 	ur repository.UserRepository,
 	vr repository.VoidSessionRepository,
 	ar repository.ActivityRepository,
+	fr repository.FriendshipRepository,
+	br repository.BlockRepository,
+	cr repository.CircleRepository,
+	srr repository.SessionReactionRepository,
+	vrr repository.VoidRoomRepository,
+	fs FeedService,
 	rs *VoidReminderScheduler,
 ) VoidService {
 	return &voidService{
 		userRepo:          ur,
 		voidSessionRepo:   vr,
 		activityRepo:      ar,
+		friendshipRepo:    fr,
+		blockRepo:         br,
+		circleRepo:        cr,
+		reactionRepo:      srr,
+		roomRepo:          vrr,
+		feedSvc:           fs,
 		reminderScheduler: rs,
 	}
 }
@@ -66,10 +97,17 @@ func (s *voidService) Start(ctx context.Context, userID string) (*dto.VoidStartR
 		s.reminderScheduler.Schedule(oid, now, user.NotificationSettings.ReminderHours)
 	}
 
+	room, err := s.roomRepo.FindOpenByMemberID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find void room: " + err.Error())
+	}
+	s.touchRoom(ctx, room, now)
+
 	return &dto.VoidStartResponse{
 		SessionID: "", // 세션은 종료 시 생성
 		StartedAt: now,
 		TargetDay: calcTargetDay(now),
+		RoomID:    roomIDHex(roomID(room)),
 	}, nil
 }
 
@@ -111,6 +149,18 @@ func (s *voidService) End(ctx context.Context, userID string, req dto.VoidEndReq
 	durationSec := int64(now.Sub(startedAt).Seconds())
 	targetDay := calcTargetDay(startedAt)
 
+	// 최고 기록 경신 여부는 이번 세션을 저장하기 전 통계와 비교. 실패하면 피드의 최고 기록만 건너뜀
+	previous, err := s.voidSessionRepo.AggregateUserStats(ctx, oid)
+	if err != nil {
+		log.Printf("[VOID] failed to aggregate stats for user %s: %v\n", userID, err)
+	}
+
+	// 끝낼 때 들어가 있는 방에 세션을 연결
+	room, err := s.roomRepo.FindOpenByMemberID(ctx, oid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find void room: " + err.Error())
+	}
+
 	session := &model.VoidSession{
 		UserID:      oid,
 		StartedAt:   startedAt,
@@ -118,6 +168,7 @@ func (s *voidService) End(ctx context.Context, userID string, req dto.VoidEndReq
 		DurationSec: durationSec,
 		TargetDay:   targetDay,
 		Activities:  req.Activities,
+		RoomID:      roomID(room),
 		CreatedAt:   now,
 	}
 
@@ -128,10 +179,12 @@ func (s *voidService) End(ctx context.Context, userID string, req dto.VoidEndReq
 	if err := s.userRepo.SetVoidState(ctx, oid, false, nil, &now); err != nil {
 		return nil, domain.NewInternal("failed to reset void state: " + err.Error())
 	}
+	s.touchRoom(ctx, room, now)
 
 	// TODO : 트랜잭션 처리
 
 	s.reminderScheduler.Cancel(userID)
+	s.feedSvc.PublishSessionEnded(ctx, session, previous)
 
 	return &dto.VoidEndResponse{
 		SessionID:   session.ID.Hex(),
@@ -140,6 +193,7 @@ func (s *voidService) End(ctx context.Context, userID string, req dto.VoidEndReq
 		DurationSec: durationSec,
 		TargetDay:   targetDay,
 		Activities:  req.Activities,
+		RoomID:      roomIDHex(session.RoomID),
 	}, nil
 }
 
@@ -178,17 +232,41 @@ func (s *voidService) History(ctx context.Context, userID string, targetDay stri
 		return nil, domain.NewInternal("failed to find void sessions: " + err.Error())
 	}
 
+	counts := make(map[primitive.ObjectID]map[string]int)
+	if len(sessions) > 0 {
+		sessionIDs := make([]primitive.ObjectID, len(sessions))
+		for i, session := range sessions {
+			sessionIDs[i] = session.ID
+		}
+		reactionCounts, err := s.reactionRepo.CountBySessionIDs(ctx, sessionIDs)
+		if err != nil {
+			return nil, domain.NewInternal("failed to count reactions: " + err.Error())
+		}
+		for _, c := range reactionCounts {
+			if counts[c.SessionID] == nil {
+				counts[c.SessionID] = make(map[string]int)
+			}
+			counts[c.SessionID][string(c.Emoji)] = c.Count
+		}
+	}
+
 	items := make([]dto.VoidSession, len(sessions))
 	var totalDuration int64
-	for i, s := range sessions {
+	for i, session := range sessions {
+		reactions := counts[session.ID]
+		if reactions == nil {
+			reactions = map[string]int{}
+		}
 		items[i] = dto.VoidSession{
-			SessionID:   s.ID.Hex(),
-			StartedAt:   s.StartedAt,
-			EndedAt:     s.EndedAt,
-			DurationSec: s.DurationSec,
-			Activities:  s.Activities,
+			SessionID:      session.ID.Hex(),
+			StartedAt:      session.StartedAt,
+			EndedAt:        session.EndedAt,
+			DurationSec:    session.DurationSec,
+			Activities:     session.Activities,
+			ReactionCounts: reactions,
+			RoomID:         roomIDHex(session.RoomID),
 		}
-		totalDuration += s.DurationSec
+		totalDuration += session.DurationSec
 	}
 
 	return &dto.VoidHistoryResponse{
@@ -236,6 +314,137 @@ func (s *voidService) TestCreate(ctx context.Context, userID string, req dto.Tes
 	}, nil
 }
 
+// React 는 친구의 끝난 공백에 반응을 남긴다. 이미 남긴 반응이 있으면 바꾼다.
+// 볼 수 없는 세션은 존재 여부를 드러내지 않도록 모두 SESSION_NOT_FOUND 로 응답한다.
+func (s *voidService) React(ctx context.Context, userID string, sessionID string, req dto.SessionReactionRequest) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	emoji := model.ReactionEmoji(req.Emoji)
+	if !slices.Contains(sessionReactions, emoji) {
+		return domain.NewBadRequest(domain.ErrInvalidReaction, "invalid reaction")
+	}
+
+	session, err := s.findFriendSession(ctx, oid, sessionID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	reaction := &model.SessionReaction{
+		SessionID: session.ID,
+		OwnerID:   session.UserID,
+		UserID:    oid,
+		Emoji:     emoji,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.reactionRepo.Upsert(ctx, reaction); err != nil {
+		return domain.NewInternal("failed to save reaction: " + err.Error())
+	}
+	return nil
+}
+
+// RemoveReaction 은 내가 남긴 반응을 지운다. 남긴 반응이 없어도 성공으로 처리한다.
+func (s *voidService) RemoveReaction(ctx context.Context, userID string, sessionID string) error {
+	oid, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return domain.NewUnauthorized(domain.ErrUnauthorized, "invalid user id")
+	}
+
+	sessionOid, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return domain.NewNotFound(domain.ErrSessionNotFound, "session not found")
+	}
+
+	if _, err := s.reactionRepo.Delete(ctx, sessionOid, oid); err != nil {
+		return domain.NewInternal("failed to delete reaction: " + err.Error())
+	}
+	return nil
+}
+
+// findFriendSession 은 viewerID 가 반응할 수 있는 친구의 세션을 찾는다.
+// 내 세션, 친구가 아니거나 차단 관계인 유저의 세션, 공개 범위 밖의 세션은 찾지 못한 것으로 본다.
+func (s *voidService) findFriendSession(ctx context.Context, viewerID primitive.ObjectID, sessionID string) (*model.VoidSession, error) {
+	notFound := domain.NewNotFound(domain.ErrSessionNotFound, "session not found")
+
+	sessionOid, err := primitive.ObjectIDFromHex(sessionID)
+	if err != nil {
+		return nil, notFound
+	}
+
+	session, err := s.voidSessionRepo.FindByID(ctx, sessionOid)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find void session: " + err.Error())
+	}
+	if session == nil || session.Hidden || session.UserID == viewerID {
+		return nil, notFound
+	}
+
+	friendship, err := s.friendshipRepo.FindOne(ctx, viewerID, session.UserID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to check friendship: " + err.Error())
+	}
+	if friendship == nil {
+		return nil, notFound
+	}
+
+	for _, pair := range [][2]primitive.ObjectID{{viewerID, session.UserID}, {session.UserID, viewerID}} {
+		block, err := s.blockRepo.FindOne(ctx, pair[0], pair[1])
+		if err != nil {
+			return nil, domain.NewInternal("failed to check block: " + err.Error())
+		}
+		if block != nil {
+			return nil, notFound
+		}
+	}
+
+	owner, err := s.userRepo.FindByID(ctx, session.UserID)
+	if err != nil {
+		return nil, domain.NewInternal("failed to find user: " + err.Error())
+	}
+	if owner == nil || owner.DeletionRequestedAt != nil {
+		return nil, notFound
+	}
+
+	viewer, err := loadCircleViewer(ctx, s.circleRepo, viewerID, []model.User{*owner})
+	if err != nil {
+		return nil, domain.NewInternal("failed to find circles: " + err.Error())
+	}
+	if !viewer.canSee(owner.ID, owner.PrivacySettings.SessionCircles) {
+		return nil, notFound
+	}
+
+	return session, nil
+}
+
+// touchRoom 은 방 멤버의 공백 시작·종료를 방 활동으로 기록해 방이 쉬는 중으로 닫히지 않게 한다.
+func (s *voidService) touchRoom(ctx context.Context, room *model.VoidRoom, at time.Time) {
+	if room == nil {
+		return
+	}
+	if err := s.roomRepo.Touch(ctx, room.ID, at); err != nil {
+		log.Printf("[VOID] failed to touch void room %s: %v\n", room.ID.Hex(), err)
+	}
+}
+
+func roomID(room *model.VoidRoom) *primitive.ObjectID {
+	if room == nil {
+		return nil
+	}
+	return &room.ID
+}
+
+func roomIDHex(id *primitive.ObjectID) *string {
+	if id == nil {
+		return nil
+	}
+	hex := id.Hex()
+	return &hex
+}
+
 func calcTargetDay(t time.Time) string {
 	return config.CalcTargetDay(t)
 }
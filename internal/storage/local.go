@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage 는 로컬 디렉터리에 파일을 저장하고 서버가 직접 정적 파일로 서빙한다.
+type LocalStorage struct {
+	Dir        string // 저장 디렉터리 (STORAGE_LOCAL_DIR)
+	PublicPath string // 서빙 경로 (STORAGE_PUBLIC_PATH)
+	baseURL    string
+}
+
+func NewLocalStorage() *LocalStorage {
+	dir := os.Getenv("STORAGE_LOCAL_DIR")
+	if dir == "" {
+		dir = "uploads"
+	}
+	publicPath := os.Getenv("STORAGE_PUBLIC_PATH")
+	if publicPath == "" {
+		publicPath = "/media"
+	}
+
+	log.Printf("[STORAGE] using local storage at %s\n", dir)
+	return &LocalStorage{
+		Dir:        dir,
+		PublicPath: "/" + strings.Trim(publicPath, "/"),
+		baseURL:    strings.TrimRight(os.Getenv("STORAGE_PUBLIC_BASE_URL"), "/"),
+	}
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// 쓰는 도중에 서빙되지 않도록 임시 파일에 쓴 뒤 교체
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// URL 은 STORAGE_PUBLIC_BASE_URL 이 없으면 서버 기준 상대 경로를 반환한다.
+func (s *LocalStorage) URL(key string) string {
+	return s.baseURL + s.PublicPath + "/" + key
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return "", errors.New("invalid storage key")
+	}
+	return filepath.Join(s.Dir, clean), nil
+}
@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// s3Storage 는 S3 호환 저장소(AWS S3, R2, MinIO 등)에 path-style 요청으로 저장한다.
+type s3Storage struct {
+	client        *http.Client
+	endpoint      string
+	region        string
+	bucket        string
+	accessKey     string
+	secretKey     string
+	publicBaseURL string
+}
+
+func NewS3Storage() (BlobStorage, error) {
+	return newS3Storage(os.Getenv("S3_BUCKET"), os.Getenv("S3_PUBLIC_BASE_URL"))
+}
+
+func newS3Storage(bucket string, publicBaseURL string) (BlobStorage, error) {
+	s := &s3Storage{
+		client:        &http.Client{Timeout: 10 * time.Second},
+		endpoint:      strings.TrimRight(os.Getenv("S3_ENDPOINT"), "/"),
+		region:        os.Getenv("S3_REGION"),
+		bucket:        bucket,
+		accessKey:     os.Getenv("S3_ACCESS_KEY_ID"),
+		secretKey:     os.Getenv("S3_SECRET_ACCESS_KEY"),
+		publicBaseURL: strings.TrimRight(publicBaseURL, "/"),
+	}
+
+	if s.endpoint == "" || s.bucket == "" || s.accessKey == "" || s.secretKey == "" {
+		return nil, errors.New("S3 storage not configured")
+	}
+	if s.region == "" {
+		s.region = "auto"
+	}
+	if s.publicBaseURL == "" {
+		s.publicBaseURL = s.endpoint + "/" + s.bucket
+	}
+
+	log.Printf("[STORAGE] using S3 storage %s/%s\n", s.endpoint, s.bucket)
+	return s, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	_, err = s.do(req, data)
+	return err
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.do(req, nil)
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	_, err = s.do(req, nil)
+	return err
+}
+
+func (s *s3Storage) URL(key string) string {
+	return s.publicBaseURL + "/" + key
+}
+
+func (s *s3Storage) newRequest(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	u, err := url.Parse(s.endpoint + "/" + s.bucket + "/" + strings.TrimLeft(key, "/"))
+	if err != nil {
+		return nil, err
+	}
+	return http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+}
+
+func (s *s3Storage) do(req *http.Request, body []byte) ([]byte, error) {
+	s.sign(req, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && req.Method == http.MethodGet {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("s3 %s %s: %d %s", req.Method, req.URL.Path, resp.StatusCode, msg)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// sign 은 AWS Signature Version 4 로 요청에 서명한다.
+func (s *s3Storage) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+)
+
+var ErrNotFound = errors.New("storage object not found")
+
+// BlobStorage 는 업로드 파일(아바타 등)을 저장하는 백엔드
+type BlobStorage interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error) // 없으면 ErrNotFound
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+// STORAGE_BACKEND 에 따라 저장소를 생성한다. ("local" 기본값, "s3")
+// 여러 인스턴스가 같은 파일을 봐야 하므로 s3 설정이 잘못되었으면 로컬로 바꾸지 않고 에러를 반환한다.
+func NewBlobStorage() (BlobStorage, error) {
+	switch backend := os.Getenv("STORAGE_BACKEND"); backend {
+	case "", "local":
+		return NewLocalStorage(), nil
+	case "s3":
+		return NewS3Storage()
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", backend)
+	}
+}
+
+// NewPrivateStorage 는 공개 URL 로 서빙하지 않는 저장소(데이터 내보내기 등)를 생성한다.
+// STORAGE_BACKEND 가 "s3" 이면 PRIVATE_S3_BUCKET, 아니면 PRIVATE_STORAGE_LOCAL_DIR(기본 "private")에 저장한다.
+func NewPrivateStorage() (BlobStorage, error) {
+	if os.Getenv("STORAGE_BACKEND") == "s3" {
+		s, err := newS3Storage(os.Getenv("PRIVATE_S3_BUCKET"), "")
+		if err != nil {
+			return nil, fmt.Errorf("private %w", err)
+		}
+		return s, nil
+	}
+
+	dir := os.Getenv("PRIVATE_STORAGE_LOCAL_DIR")
+	if dir == "" {
+		dir = "private"
+	}
+	log.Printf("[STORAGE] using local private storage at %s\n", dir)
+	return &LocalStorage{Dir: dir}, nil
+}